@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/gist"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/oauth"
+	"github.com/tinysolver/rules-cli/output"
+	"github.com/tinysolver/rules-cli/prompt"
+)
+
+// tokenInputHint 토큰을 입력받을 수 없을 때 안내
+const tokenInputHint = "토큰을 파이프로 전달하거나(echo $TOKEN | cursorrules auth) CURSORRULES_TOKEN 환경 변수를 사용하세요"
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "GitHub Personal Access Token 설정",
+	Long: "GitHub Personal Access Token을 입력받아 확인한 뒤 저장합니다.\n" +
+		"토큰으로 로그인 계정을 조회하고 gist 권한이 있는지 확인한 후에만 저장합니다.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return fail(clierr.Auth, promptToken())
+	},
+}
+
+var authLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "GitHub 로그인",
+	Long: "GitHub에 로그인합니다. --device 옵션을 사용하면 토큰을 붙여넣는 대신\n" +
+		"브라우저에서 인증 코드를 입력하는 OAuth 기기 인증 흐름으로 토큰을 발급받습니다.\n" +
+		"설정 파일의 oauth_client_id에 기기 인증을 허용한 OAuth 앱의 클라이언트 ID가 필요합니다.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		device, _ := cmd.Flags().GetBool("device")
+		if !device {
+			return fail(clierr.Auth, promptToken())
+		}
+		return fail(clierr.Auth, deviceLogin())
+	},
+}
+
+// promptToken 토큰을 입력받아 확인한 뒤 저장
+func promptToken() error {
+	if err := config.InitConfig(); err != nil {
+		return clierr.New(clierr.IO, "설정 초기화 실패: %w", err)
+	}
+
+	// 파이프로 전달한 토큰이 없으면 화면에 표시되지 않도록 입력받음
+	token, piped, err := prompt.Piped()
+	if err == nil && !piped {
+		token, err = prompt.Secret(i18n.T("GitHub Personal Access Token"), i18n.T(tokenInputHint))
+	}
+	if err != nil {
+		return fail(clierr.IO, err)
+	}
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return clierr.New(clierr.Validation, "토큰이 입력되지 않았습니다. %s", i18n.T(tokenInputHint))
+	}
+
+	return saveCheckedToken(token)
+}
+
+// deviceLogin OAuth 기기 인증 흐름으로 토큰을 발급받아 저장
+func deviceLogin() error {
+	clientID := config.GetOAuthClientID()
+	if clientID == "" {
+		return clierr.New(clierr.Validation, "OAuth 클라이언트 ID가 설정되지 않았습니다. 설정 파일의 oauth_client_id를 지정하세요")
+	}
+
+	ctx := context.Background()
+	flow := oauth.NewDeviceFlow(oauthBaseURL(), clientID, []string{gist.RequiredScope})
+	code, err := flow.RequestCode(ctx)
+	if err != nil {
+		return err
+	}
+
+	output.Printf("브라우저에서 %s 에 접속해 다음 코드를 입력하세요: %s\n", code.VerificationURI, code.UserCode)
+	output.Printf("인증을 기다리는 중...\n")
+
+	token, err := flow.PollToken(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	return saveCheckedToken(token)
+}
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "현재 사용 중인 토큰의 계정, 권한, 출처 확인",
+	Long: "토큰은 다음 순서로 찾습니다:\n" +
+		"  1. 프로필의 token_command 실행 결과\n" +
+		"  2. 'cursorrules auth'로 저장한 프로필의 토큰 (OS 키링 또는 암호화 파일)\n" +
+		"  3. CURSORRULES_TOKEN 환경 변수\n" +
+		"  4. GITHUB_TOKEN 환경 변수\n" +
+		"  5. 설정 파일의 token_command 실행 결과\n" +
+		"3~5는 github.com을 사용하는 프로필에만 적용합니다.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		token, source, err := config.ResolveToken()
+		if err != nil {
+			return fail(clierr.Auth, i18n.Errorf("토큰 조회 실패: %w", err))
+		}
+
+		if token == "" {
+			return fail(clierr.Auth, i18n.NewError("GitHub 토큰이 설정되지 않았습니다. 'cursorrules auth' 명령어로 토큰을 설정하세요."))
+		}
+
+		info, err := gist.CheckToken(token)
+		if err != nil {
+			return fail(clierr.Auth, i18n.Errorf("토큰 확인 실패: %w", err))
+		}
+
+		profile, _ := config.ActiveProfile()
+		result := authStatus{
+			Profile:     profile,
+			Source:      string(source),
+			Token:       maskToken(token),
+			Login:       info.Login,
+			Scopes:      info.Scopes,
+			ScopesKnown: info.ScopesKnown,
+			GistAccess:  info.GistAccess,
+		}
+		if result.Scopes == nil {
+			result.Scopes = []string{}
+		}
+		return printResult(result, func() {
+			i18n.Printf("토큰 출처: %s\n", source.Label())
+			i18n.Printf("토큰: %s\n", maskToken(token))
+			i18n.Printf("로그인: %s\n", info.Login)
+			if info.ScopesKnown {
+				i18n.Printf("권한: %s\n", describeScopes(info.Scopes))
+				if !info.HasScope(gist.RequiredScope) {
+					i18n.Printf("경고: 토큰에 %s 권한이 없어 템플릿을 저장할 수 없습니다.\n", gist.RequiredScope)
+				}
+			} else {
+				i18n.Printf("권한: 확인할 수 없음 (fine-grained 토큰)\n")
+				if !info.GistAccess {
+					i18n.Printf("경고: 토큰으로 Gist에 접근할 수 없어 템플릿을 사용할 수 없습니다.\n")
+				}
+			}
+		})
+	},
+}
+
+// authStatus auth status 명령 결과
+type authStatus struct {
+	Profile     string   `json:"profile"`
+	Source      string   `json:"source"` // 토큰 출처 식별자 (config.TokenSource)
+	Token       string   `json:"token"`  // 앞뒤 일부만 남긴 토큰
+	Login       string   `json:"login"`
+	Scopes      []string `json:"scopes"`
+	ScopesKnown bool     `json:"scopes_known"` // fine-grained 토큰이면 false
+	GistAccess  bool     `json:"gist_access"`  // Gist에 접근할 수 있는지 여부
+}
+
+// authResult auth, auth login 명령 결과
+type authResult struct {
+	Login string `json:"login"`
+	Store string `json:"store"` // 토큰을 저장한 비밀 값 저장소 식별자 (keyring, file)
+}
+
+// saveCheckedToken 토큰의 로그인 계정과 gist 권한을 확인한 뒤 저장
+func saveCheckedToken(token string) error {
+	info, err := gist.CheckToken(token)
+	if err != nil {
+		return i18n.Errorf("토큰 확인 실패: %w", err)
+	}
+
+	// 클래식 토큰은 권한 헤더로, fine-grained 토큰은 Gist 목록 조회 결과로 검사
+	if info.ScopesKnown && !info.HasScope(gist.RequiredScope) {
+		return clierr.New(clierr.Auth, "토큰에 %s 권한이 없습니다 (현재 권한: %s). %s 권한을 포함한 토큰을 발급하세요",
+			gist.RequiredScope, describeScopes(info.Scopes), gist.RequiredScope)
+	}
+	if !info.ScopesKnown {
+		if !info.GistAccess {
+			return clierr.New(clierr.Auth, "토큰으로 Gist에 접근할 수 없습니다. fine-grained 토큰에 Gists 읽기/쓰기 권한을 부여하세요")
+		}
+		output.Warnf("경고: fine-grained 토큰은 쓰기 권한을 확인할 수 없습니다. Gists 쓰기 권한이 있는지 확인하세요.\n")
+	}
+
+	if err := config.SaveToken(token); err != nil {
+		return clierr.New(clierr.IO, "토큰 저장 실패: %w", err)
+	}
+
+	store, err := config.GetSecretStore()
+	if err != nil {
+		return clierr.New(clierr.IO, "비밀 값 저장소 조회 실패: %w", err)
+	}
+
+	return printResult(authResult{Login: info.Login, Store: store.ID()}, func() {
+		i18n.Printf("%s 계정으로 인증되었습니다. 토큰이 %s에 저장되었습니다.\n", info.Login, store.Name())
+	})
+}
+
+// oauthBaseURL 기기 인증 OAuth 서버 주소
+// oauth_base_url이 없고 프로필이 GitHub Enterprise를 가리키면 API 주소의 호스트를 사용한다.
+func oauthBaseURL() string {
+	if base := config.GetOAuthBaseURL(); base != "" {
+		return base
+	}
+	if profile, err := config.GetActiveProfile(); err == nil && profile.APIURL != "" {
+		if u, err := url.Parse(profile.APIURL); err == nil {
+			return u.Scheme + "://" + u.Host
+		}
+	}
+	return oauth.DefaultBaseURL
+}
+
+// describeScopes 권한 목록 문자열
+func describeScopes(scopes []string) string {
+	if len(scopes) == 0 {
+		return i18n.T("없음")
+	}
+	return strings.Join(scopes, ", ")
+}
+
+// maskToken 토큰의 앞 몇 글자만 남기고 가림
+func maskToken(token string) string {
+	if len(token) <= 8 {
+		return strings.Repeat("*", len(token))
+	}
+	return token[:4] + strings.Repeat("*", 8)
+}
+
+func init() {
+	authLoginCmd.Flags().Bool("device", false, "OAuth 기기 인증 흐름으로 로그인 (기본값이 없으므로 oauth_client_id 설정 필요)")
+	authCmd.AddCommand(authLoginCmd)
+	authCmd.AddCommand(authStatusCmd)
+}
@@ -0,0 +1,331 @@
+package bundle
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tinysolver/rules-cli/gist"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+const (
+	// filesDir 번들 안에서 규칙 파일이 위치하는 디렉토리
+	filesDir = "rules/"
+)
+
+// 압축 해제로 메모리를 소진하지 않도록 읽는 크기 제한 (테스트에서 줄이기 위해 변수로 둠)
+var (
+	// maxEntrySize 항목 하나의 최대 크기
+	maxEntrySize int64 = 10 << 20
+	// maxTotalSize 번들 전체 항목의 최대 크기
+	maxTotalSize int64 = 50 << 20
+)
+
+// Format 번들 파일 형식
+type Format string
+
+const (
+	FormatJSON  Format = "json"
+	FormatTarGz Format = "tar.gz"
+	FormatZip   Format = "zip"
+)
+
+// DetectFormat 파일 이름의 확장자로 번들 형식 판별
+func DetectFormat(filename string) (Format, error) {
+	lower := strings.ToLower(filename)
+	switch {
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		return FormatTarGz, nil
+	case strings.HasSuffix(lower, ".zip"):
+		return FormatZip, nil
+	case strings.HasSuffix(lower, ".json"):
+		return FormatJSON, nil
+	}
+	return "", i18n.Errorf("지원하지 않는 번들 형식입니다: %s (.tar.gz, .zip, .json)", filename)
+}
+
+// NewManifest 템플릿 파일 목록으로 매니페스트 생성
+func NewManifest(template *models.Template) *models.TemplateVersion {
+	manifest := models.NewTemplateVersion(template.Name, "v1.0.0")
+	manifest.Description = template.Description
+	for filePath, rule := range template.Files {
+		manifest.AddFile(filePath, time.Now(), models.HashContent(rule.Content))
+	}
+	return manifest
+}
+
+// Contents 번들에 저장하는 템플릿 내용
+// Files는 매니페스트에 기록된 그대로의 내용(암호화된 템플릿은 암호문)이며,
+// 서명 검증을 위해 매니페스트와 서명은 원문 그대로 보관한다.
+type Contents struct {
+	Name      string
+	Files     map[string]string // 규칙 디렉토리 기준 경로별 내용
+	Manifest  string            // 매니페스트 JSON (없으면 빈 문자열)
+	Signature string            // 매니페스트 서명 (없으면 빈 문자열)
+}
+
+// Pack 템플릿 내용을 번들 파일로 저장
+// .json은 템플릿 JSON만 저장하므로 매니페스트와 서명이 포함되지 않는다.
+// 압축 형식은 매니페스트, 서명과 디렉토리 구조를 함께 저장한다.
+func Pack(contents Contents, filename string) error {
+	format, err := DetectFormat(filename)
+	if err != nil {
+		return err
+	}
+
+	if format == FormatJSON {
+		template := models.NewTemplate(contents.Name, "")
+		for filePath, content := range contents.Files {
+			template.AddFile(filePath, content, filePath)
+		}
+		return template.SaveToFile(filename)
+	}
+
+	if contents.Manifest == "" {
+		return i18n.Errorf("번들에 저장할 매니페스트가 없습니다")
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return i18n.Errorf("번들 파일 생성 실패: %w", err)
+	}
+	defer file.Close()
+
+	entries := map[string]string{gist.ManifestFile: contents.Manifest}
+	if contents.Signature != "" {
+		entries[gist.SignatureFile] = contents.Signature
+	}
+	for filePath, content := range contents.Files {
+		entries[filesDir+path.Clean(filepath.ToSlash(filePath))] = content
+	}
+
+	switch format {
+	case FormatTarGz:
+		err = writeTarGz(file, entries)
+	case FormatZip:
+		err = writeZip(file, entries)
+	}
+	if err != nil {
+		return i18n.Errorf("번들 작성 실패: %w", err)
+	}
+
+	return file.Close()
+}
+
+// Unpack 번들 파일에서 템플릿 내용 로드
+// 해시와 서명은 검증하지 않으므로 호출하는 쪽에서 Gist 내용과 같은 방식으로 검증해야 한다.
+// .json 번들은 매니페스트가 없으므로 Manifest와 Signature가 비어 있다.
+// 규칙 디렉토리 밖을 가리키는 경로나 크기 제한을 넘는 항목이 있으면 실패한다.
+func Unpack(filename string) (Contents, error) {
+	format, err := DetectFormat(filename)
+	if err != nil {
+		return Contents{}, err
+	}
+
+	if format == FormatJSON {
+		template, err := models.LoadFromFile(filename)
+		if err != nil {
+			return Contents{}, err
+		}
+		contents := Contents{Name: template.Name, Files: make(map[string]string, len(template.Files))}
+		for filePath, rule := range template.Files {
+			if err := checkEntryName(filePath); err != nil {
+				return Contents{}, err
+			}
+			contents.Files[filePath] = rule.Content
+		}
+		return contents, nil
+	}
+
+	var entries map[string]string
+	switch format {
+	case FormatTarGz:
+		entries, err = readTarGz(filename)
+	case FormatZip:
+		entries, err = readZip(filename)
+	}
+	if err != nil {
+		return Contents{}, i18n.Errorf("번들 읽기 실패: %w", err)
+	}
+
+	manifestData, ok := entries[gist.ManifestFile]
+	if !ok {
+		return Contents{}, i18n.Errorf("번들에 %s 파일이 없습니다", gist.ManifestFile)
+	}
+	manifest, err := models.FromJSONString(manifestData)
+	if err != nil {
+		return Contents{}, err
+	}
+
+	contents := Contents{
+		Name:      manifest.Name,
+		Files:     make(map[string]string),
+		Manifest:  manifestData,
+		Signature: entries[gist.SignatureFile],
+	}
+	for name, content := range entries {
+		if strings.HasPrefix(name, filesDir) {
+			contents.Files[strings.TrimPrefix(name, filesDir)] = content
+		}
+	}
+
+	return contents, nil
+}
+
+// checkEntryName 번들 항목 이름이 번들 안의 상대 경로인지 확인
+func checkEntryName(name string) error {
+	slashed := filepath.ToSlash(name)
+	cleaned := path.Clean(slashed)
+	if name == "" || path.IsAbs(slashed) || filepath.IsAbs(name) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return i18n.Errorf("번들에 올바르지 않은 경로가 있습니다: %s", name)
+	}
+	return nil
+}
+
+// entryReader 크기 제한을 지키며 번들 항목을 읽음
+type entryReader struct {
+	total int64 // 지금까지 읽은 크기
+}
+
+// read 항목 하나를 읽고, 항목이나 번들 전체가 제한을 넘으면 실패
+func (e *entryReader) read(name string, r io.Reader) (string, error) {
+	if err := checkEntryName(name); err != nil {
+		return "", err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, maxEntrySize+1))
+	if err != nil {
+		return "", err
+	}
+	if int64(len(data)) > maxEntrySize {
+		return "", i18n.Errorf("번들 항목이 너무 큽니다: %s (최대 %d바이트)", name, maxEntrySize)
+	}
+	e.total += int64(len(data))
+	if e.total > maxTotalSize {
+		return "", i18n.Errorf("번들 내용이 너무 큽니다 (최대 %d바이트)", maxTotalSize)
+	}
+	return string(data), nil
+}
+
+// sortedNames 항목 이름 정렬 (번들 내용이 항상 같은 순서가 되도록)
+func sortedNames(entries map[string]string) []string {
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func writeTarGz(w io.Writer, entries map[string]string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	now := time.Now()
+	for _, name := range sortedNames(entries) {
+		content := entries[name]
+		header := &tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(content)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(tw, content); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func readTarGz(filename string) (map[string]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	entries := make(map[string]string)
+	reader := &entryReader{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := reader.read(header.Name, tr)
+		if err != nil {
+			return nil, err
+		}
+		entries[header.Name] = data
+	}
+	return entries, nil
+}
+
+func writeZip(w io.Writer, entries map[string]string) error {
+	zw := zip.NewWriter(w)
+	for _, name := range sortedNames(entries) {
+		fw, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(fw, entries[name]); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func readZip(filename string) (map[string]string, error) {
+	zr, err := zip.OpenReader(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	entries := make(map[string]string)
+	reader := &entryReader{}
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := reader.read(f.Name, rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		entries[f.Name] = data
+	}
+	return entries, nil
+}
@@ -0,0 +1,212 @@
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tinysolver/rules-cli/models"
+)
+
+// newContents 테스트용 번들 내용
+func newContents(t *testing.T) Contents {
+	t.Helper()
+	template := models.NewTemplate("acme/rules", "")
+	template.AddFile("a.mdc", "---\ndescription: a\n---\nA\n", "a.mdc")
+	template.AddFile("sub/dir/b.mdc", "B\n", "sub/dir/b.mdc")
+
+	manifest, err := NewManifest(template).ToJSONString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Contents{
+		Name:      "acme/rules",
+		Files:     map[string]string{"a.mdc": template.Files["a.mdc"].Content, "sub/dir/b.mdc": "B\n"},
+		Manifest:  manifest,
+		Signature: "ed25519 서명\n",
+	}
+}
+
+// entryNames 압축 번들의 항목 이름 (정렬)
+func entryNames(t *testing.T, filename string) []string {
+	t.Helper()
+	var entries map[string]string
+	var err error
+	if strings.HasSuffix(filename, ".zip") {
+		entries, err = readZip(filename)
+	} else {
+		entries, err = readTarGz(filename)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sortedNames(entries)
+}
+
+func TestPackUnpack(t *testing.T) {
+	tests := []struct {
+		filename    string
+		wantEntries []string // 압축 번들의 항목 (.json은 nil)
+	}{
+		{filename: "rules.tar.gz", wantEntries: []string{"manifest.json", "manifest.sig", "rules/a.mdc", "rules/sub/dir/b.mdc"}},
+		{filename: "rules.tgz", wantEntries: []string{"manifest.json", "manifest.sig", "rules/a.mdc", "rules/sub/dir/b.mdc"}},
+		{filename: "rules.zip", wantEntries: []string{"manifest.json", "manifest.sig", "rules/a.mdc", "rules/sub/dir/b.mdc"}},
+		{filename: "rules.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			contents := newContents(t)
+			filename := filepath.Join(t.TempDir(), tt.filename)
+			if err := Pack(contents, filename); err != nil {
+				t.Fatalf("Pack(): %v", err)
+			}
+
+			if tt.wantEntries != nil {
+				if got := entryNames(t, filename); strings.Join(got, ",") != strings.Join(tt.wantEntries, ",") {
+					t.Errorf("entries = %v, want %v", got, tt.wantEntries)
+				}
+			}
+
+			got, err := Unpack(filename)
+			if err != nil {
+				t.Fatalf("Unpack(): %v", err)
+			}
+			if got.Name != contents.Name {
+				t.Errorf("Name = %q, want %q", got.Name, contents.Name)
+			}
+			if len(got.Files) != len(contents.Files) {
+				t.Errorf("Files = %v, want %v", got.Files, contents.Files)
+			}
+			for filePath, content := range contents.Files {
+				if got.Files[filePath] != content {
+					t.Errorf("Files[%s] = %q, want %q", filePath, got.Files[filePath], content)
+				}
+			}
+
+			// .json 번들은 매니페스트와 서명을 저장하지 않음
+			wantManifest, wantSignature := contents.Manifest, contents.Signature
+			if tt.wantEntries == nil {
+				wantManifest, wantSignature = "", ""
+			}
+			if got.Manifest != wantManifest || got.Signature != wantSignature {
+				t.Errorf("Manifest, Signature = %q, %q, want %q, %q", got.Manifest, got.Signature, wantManifest, wantSignature)
+			}
+		})
+	}
+}
+
+func TestPackWithoutManifest(t *testing.T) {
+	contents := newContents(t)
+	contents.Manifest = ""
+	if err := Pack(contents, filepath.Join(t.TempDir(), "rules.tar.gz")); err == nil {
+		t.Error("Pack() without manifest: want error")
+	}
+}
+
+func TestUnpackInvalidEntries(t *testing.T) {
+	manifest := newContents(t).Manifest
+	tests := []struct {
+		name  string
+		entry string
+	}{
+		{name: "상위 디렉토리", entry: "rules/../../evil.mdc"},
+		{name: "상위 디렉토리로 시작", entry: "../evil.mdc"},
+		{name: "절대 경로", entry: "/etc/evil.mdc"},
+	}
+
+	for _, tt := range tests {
+		for _, ext := range []string{".tar.gz", ".zip"} {
+			t.Run(tt.name+ext, func(t *testing.T) {
+				filename := filepath.Join(t.TempDir(), "evil"+ext)
+				file, err := os.Create(filename)
+				if err != nil {
+					t.Fatal(err)
+				}
+				entries := map[string]string{"manifest.json": manifest, tt.entry: "evil"}
+				if ext == ".zip" {
+					err = writeZip(file, entries)
+				} else {
+					err = writeTarGz(file, entries)
+				}
+				file.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if _, err := Unpack(filename); err == nil {
+					t.Errorf("Unpack() with %q: want error", tt.entry)
+				}
+			})
+		}
+	}
+
+	t.Run("json", func(t *testing.T) {
+		template := models.NewTemplate("evil", "")
+		template.AddFile("../evil.mdc", "evil", "../evil.mdc")
+		filename := filepath.Join(t.TempDir(), "evil.json")
+		if err := template.SaveToFile(filename); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Unpack(filename); err == nil {
+			t.Error("Unpack() with ../evil.mdc: want error")
+		}
+	})
+}
+
+func TestUnpackSizeLimit(t *testing.T) {
+	entrySize, totalSize := maxEntrySize, maxTotalSize
+	t.Cleanup(func() { maxEntrySize, maxTotalSize = entrySize, totalSize })
+
+	contents := newContents(t)
+	maxEntrySize = int64(len(contents.Manifest))
+	maxTotalSize = maxEntrySize * 2
+
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr bool
+	}{
+		{name: "제한 이내", files: map[string]string{"a.mdc": "A"}},
+		{name: "항목 크기 초과", files: map[string]string{"a.mdc": strings.Repeat("x", int(maxEntrySize)+1)}, wantErr: true},
+		{name: "전체 크기 초과", files: map[string]string{"a.mdc": strings.Repeat("x", int(maxEntrySize)), "b.mdc": "B"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		for _, ext := range []string{".tar.gz", ".zip"} {
+			t.Run(tt.name+ext, func(t *testing.T) {
+				contents.Files = tt.files
+				filename := filepath.Join(t.TempDir(), "rules"+ext)
+				if err := Pack(contents, filename); err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := Unpack(filename)
+				if tt.wantErr {
+					if err == nil {
+						t.Errorf("Unpack() = %d files, want error", len(got.Files))
+					}
+				} else if err != nil {
+					t.Errorf("Unpack(): %v", err)
+				}
+			})
+		}
+	}
+}
+
+func TestCheckEntryName(t *testing.T) {
+	valid := []string{"manifest.json", "rules/a.mdc", "rules/sub/../b.mdc", "a..b.mdc"}
+	invalid := []string{"", "..", "../a.mdc", "rules/../../a.mdc", "/a.mdc"}
+
+	for _, name := range valid {
+		if err := checkEntryName(name); err != nil {
+			t.Errorf("checkEntryName(%q) = %v, want nil", name, err)
+		}
+	}
+	for _, name := range invalid {
+		if err := checkEntryName(name); err == nil {
+			t.Errorf("checkEntryName(%q) = nil, want error", name)
+		}
+	}
+}
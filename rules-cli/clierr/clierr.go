@@ -0,0 +1,100 @@
+package clierr
+
+import (
+	"errors"
+
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+// Code 오류 종류를 나타내는 고정 코드
+// 스크립트에서 오류 종류에 따라 분기할 수 있도록 값은 바꾸지 않는다.
+type Code string
+
+const (
+	// NotFound 템플릿, 프로필, 파일 등을 찾을 수 없음
+	NotFound Code = "not_found"
+	// Auth 토큰이 없거나 권한이 부족함
+	Auth Code = "auth"
+	// Conflict 로컬 파일 또는 원격 상태와 충돌
+	Conflict Code = "conflict"
+	// Validation 잘못된 인자, 설정 값, 파일 형식
+	Validation Code = "validation"
+	// Network GitHub API 등 원격 서버 요청 실패
+	Network Code = "network"
+	// Integrity 해시, 서명 검증 또는 복호화 실패
+	Integrity Code = "integrity"
+	// IO 로컬 파일 읽기/쓰기 실패
+	IO Code = "io"
+	// Canceled 사용자가 작업을 취소함
+	Canceled Code = "canceled"
+	// Internal 분류되지 않은 오류
+	Internal Code = "internal"
+)
+
+// exitCodes 오류 코드별 프로세스 종료 코드
+// 스크립트에서 종료 코드로 분기할 수 있도록 값은 바꾸지 않는다. (README의 종료 코드 표와 같게 유지)
+var exitCodes = map[Code]int{
+	Internal:   1,
+	Validation: 2,
+	NotFound:   3,
+	Auth:       4,
+	Conflict:   5,
+	Network:    6,
+	Integrity:  7,
+	IO:         8,
+	Canceled:   9,
+}
+
+// Error 코드가 붙은 오류
+type Error struct {
+	Code Code
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// New 코드가 붙은 오류 생성 (format은 현재 언어로 번역한다)
+func New(code Code, format string, args ...interface{}) error {
+	return &Error{Code: code, Err: i18n.Errorf(format, args...)}
+}
+
+// Wrap 오류에 코드 지정 (err가 nil이면 nil)
+func Wrap(code Code, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: code, Err: err}
+}
+
+// Default 코드가 없는 오류에만 code 지정
+// 하위 패키지에서 이미 분류한 오류는 그 코드를 유지한다.
+func Default(code Code, err error) error {
+	var coded *Error
+	if err == nil || errors.As(err, &coded) {
+		return err
+	}
+	return &Error{Code: code, Err: err}
+}
+
+// CodeOf 오류의 코드 조회 (코드가 없으면 Internal)
+func CodeOf(err error) Code {
+	var coded *Error
+	if errors.As(err, &coded) {
+		return coded.Code
+	}
+	return Internal
+}
+
+// ExitCode 오류에 해당하는 프로세스 종료 코드 (nil이면 0)
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	return exitCodes[CodeOf(err)]
+}
@@ -1,20 +1,86 @@
 package config
 
 import (
-	"fmt"
+	"context"
+	"encoding/json"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/secret"
 )
 
 const (
 	configDir  = ".cursorrules"
 	configFile = "config-cli.json"
+
+	// tokenKey 비밀 값 저장소에 GitHub 토큰을 저장하는 키 (이전 버전의 설정 파일 키와 같음)
+	tokenKey = "github_token"
+	// tokenCommandTimeout token_command 실행 제한 시간
+	tokenCommandTimeout = 30 * time.Second
 )
 
+// TokenSource 토큰을 읽어 온 위치 (출력 형식과 언어에 관계없이 같은 식별자)
+// 화면에 표시할 때는 Label을 사용한다.
+type TokenSource string
+
+const (
+	// TokenSourceNone 토큰이 설정되지 않음
+	TokenSourceNone TokenSource = "none"
+	// TokenSourceCommand 설정 파일의 token_command 실행 결과
+	TokenSourceCommand TokenSource = "command"
+	// TokenSourceProfileCommand 프로필의 token_command 실행 결과
+	TokenSourceProfileCommand TokenSource = "profile_command"
+	// TokenSourceKeyring OS 키링에 저장한 토큰
+	TokenSourceKeyring TokenSource = secret.ProviderKeyring
+	// TokenSourceFile 암호화 파일에 저장한 토큰
+	TokenSourceFile TokenSource = secret.ProviderFile
+	// TokenSourcePlaintext 설정 파일에 평문으로 남아 있는 토큰
+	TokenSourcePlaintext TokenSource = "plaintext"
+
+	// tokenSourceEnvPrefix 환경 변수 출처의 접두사 (예: env:GITHUB_TOKEN)
+	tokenSourceEnvPrefix = "env:"
+)
+
+// Label 토큰 출처의 표시용 이름
+func (s TokenSource) Label() string {
+	switch s {
+	case TokenSourceNone:
+		return i18n.T("없음")
+	case TokenSourceCommand:
+		return i18n.T("설정 파일의 token_command")
+	case TokenSourceProfileCommand:
+		return i18n.T("프로필의 token_command")
+	case TokenSourceKeyring:
+		return i18n.T("OS 키링")
+	case TokenSourceFile:
+		return i18n.T("암호화 파일")
+	case TokenSourcePlaintext:
+		return i18n.T("설정 파일 (평문)")
+	}
+	if env, ok := strings.CutPrefix(string(s), tokenSourceEnvPrefix); ok {
+		return i18n.Sprintf("환경 변수 %s", env)
+	}
+	return string(s)
+}
+
+// tokenEnvs 토큰을 읽는 환경 변수 (우선순위 순)
+var tokenEnvs = []string{"CURSORRULES_TOKEN", "GITHUB_TOKEN"}
+
 var initialized bool
 
+var (
+	// 기본 업로드 대상 규칙 파일 패턴
+	defaultRuleInclude = []string{"*.mdc", "*.md"}
+	// 기본 제외 패턴 (백업 파일, 버전 정보 등)
+	defaultRuleExclude = []string{"*.bak", "version.json"}
+)
+
 // InitConfig 설정 파일 초기화
 func InitConfig() error {
 	if initialized {
@@ -23,49 +89,242 @@ func InitConfig() error {
 
 	home, err := os.UserHomeDir()
 	if err != nil {
-		return fmt.Errorf("홈 디렉토리를 찾을 수 없습니다: %v", err)
+		return i18n.Errorf("홈 디렉토리를 찾을 수 없습니다: %w", err)
 	}
 
+	// 설정 디렉토리와 파일은 본인만 접근할 수 있도록 제한
 	configPath := filepath.Join(home, configDir)
-	if err := os.MkdirAll(configPath, 0755); err != nil {
-		return fmt.Errorf("설정 디렉토리를 생성할 수 없습니다: %v", err)
+	if err := os.MkdirAll(configPath, 0700); err != nil {
+		return i18n.Errorf("설정 디렉토리를 생성할 수 없습니다: %w", err)
+	}
+	if err := os.Chmod(configPath, 0700); err != nil {
+		return i18n.Errorf("설정 디렉토리 권한 변경 실패: %w", err)
 	}
 
 	viper.SetConfigName(configFile[:len(configFile)-5]) // .json 확장자 제거
 	viper.SetConfigType("json")
+	viper.SetConfigPermissions(0600)
 	viper.AddConfigPath(configPath)
 
 	// 설정 파일이 없으면 생성
 	if err := viper.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
-			return viper.WriteConfigAs(filepath.Join(configPath, configFile))
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			return i18n.Errorf("설정 파일을 읽을 수 없습니다: %w", err)
+		}
+		if err := viper.WriteConfigAs(filepath.Join(configPath, configFile)); err != nil {
+			return err
 		}
-		return fmt.Errorf("설정 파일을 읽을 수 없습니다: %v", err)
+	}
+	if err := os.Chmod(filepath.Join(configPath, configFile), 0600); err != nil {
+		return i18n.Errorf("설정 파일 권한 변경 실패: %w", err)
 	}
 
 	initialized = true
+
+	// 이전 버전에서 평문으로 저장한 토큰을 비밀 값 저장소로 이전
+	// 이전에 실패해도 평문 토큰으로 계속 동작하도록 경고만 출력한다.
+	if err := migratePlaintextToken(); err != nil {
+		i18n.Fprintf(os.Stderr, "경고: %v\n", err)
+	}
 	return nil
 }
 
-// SaveToken GitHub 토큰 저장
+// SaveToken 현재 프로필의 GitHub 토큰을 비밀 값 저장소에 저장
 func SaveToken(token string) error {
+	profile, err := GetActiveProfile()
+	if err != nil {
+		return err
+	}
+	store, err := GetSecretStore()
+	if err != nil {
+		return err
+	}
+	return store.Set(profileTokenKey(profile.Name), token)
+}
+
+// GetToken GitHub 토큰 조회 (ResolveToken의 순서를 따름)
+func GetToken() string {
+	token, _, err := ResolveToken()
+	if err != nil {
+		return ""
+	}
+	return token
+}
+
+// ResolveToken 현재 프로필의 GitHub 토큰과 출처 조회
+// 프로필 전용 토큰을 먼저 찾는다: 프로필의 token_command 실행 결과 → 비밀 값 저장소에 저장한 프로필 토큰.
+// 없으면 github.com을 사용하는 프로필에 한해 공통 토큰을 사용한다: CURSORRULES_TOKEN 환경 변수
+// → GITHUB_TOKEN 환경 변수 → 설정 파일의 token_command 실행 결과
+// → (이전하지 못한) 설정 파일의 평문 토큰 (default 프로필만)
+// 토큰을 찾지 못하면 빈 문자열과 TokenSourceNone을 반환한다.
+func ResolveToken() (string, TokenSource, error) {
+	profile, err := GetActiveProfile()
+	if err != nil {
+		return "", TokenSourceNone, err
+	}
+
+	if profile.TokenCommand != "" {
+		token, err := runTokenCommand(profile.TokenCommand)
+		if err != nil {
+			return "", TokenSourceProfileCommand, err
+		}
+		return token, TokenSourceProfileCommand, nil
+	}
+
+	if store, err := GetSecretStore(); err == nil {
+		if token, err := store.Get(profileTokenKey(profile.Name)); err == nil && token != "" {
+			return token, TokenSource(store.ID()), nil
+		}
+	}
+
+	// 공통 토큰은 github.com용이므로 다른 호스트를 가리키는 프로필에는 보내지 않는다
+	if !profile.IsGitHubCom() {
+		return "", TokenSourceNone, nil
+	}
+
+	for _, env := range tokenEnvs {
+		if token := strings.TrimSpace(os.Getenv(env)); token != "" {
+			return token, TokenSource(tokenSourceEnvPrefix + env), nil
+		}
+	}
+
+	if command := GetTokenCommand(); command != "" {
+		token, err := runTokenCommand(command)
+		if err != nil {
+			return "", TokenSourceCommand, err
+		}
+		return token, TokenSourceCommand, nil
+	}
+
+	if token := viper.GetString(tokenKey); token != "" && profile.Name == DefaultProfile {
+		return token, TokenSourcePlaintext, nil
+	}
+
+	return "", TokenSourceNone, nil
+}
+
+// GetTokenCommand 설정 파일의 공통 토큰 명령 조회 (예: "gh auth token")
+// 프로필 전용 명령은 Profile.TokenCommand를 사용한다.
+func GetTokenCommand() string {
 	if !initialized {
 		if err := InitConfig(); err != nil {
-			return err
+			return ""
 		}
 	}
-	viper.Set("github_token", token)
-	return viper.WriteConfig()
+	return viper.GetString("token_command")
 }
 
-// GetToken 저장된 GitHub 토큰 조회
-func GetToken() string {
+// runTokenCommand 셸에서 토큰 명령을 실행하고 첫 줄을 토큰으로 사용
+func runTokenCommand(command string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", i18n.Errorf("token_command 실행 실패 (%s): %w", command, err)
+	}
+
+	token, _, _ := strings.Cut(strings.TrimSpace(string(output)), "\n")
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", i18n.Errorf("token_command가 토큰을 출력하지 않았습니다 (%s)", command)
+	}
+	return token, nil
+}
+
+// GetSecretStore 설정한 비밀 값 저장소 열기 (secret_store: auto, keyring, file)
+func GetSecretStore() (secret.Store, error) {
 	if !initialized {
 		if err := InitConfig(); err != nil {
-			return ""
+			return nil, err
+		}
+	}
+
+	dir, err := GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	return secret.Open(viper.GetString("secret_store"), dir)
+}
+
+// migratePlaintextToken 설정 파일의 평문 토큰을 비밀 값 저장소로 옮기고 설정 파일에서 제거
+func migratePlaintextToken() error {
+	token := viper.GetString(tokenKey)
+	if token == "" {
+		return nil
+	}
+
+	// 이전 버전의 토큰은 default 프로필의 토큰으로 이전
+	store, err := GetSecretStore()
+	if err != nil {
+		return i18n.Errorf("토큰 이전 실패: %w", err)
+	}
+	if err := store.Set(profileTokenKey(DefaultProfile), token); err != nil {
+		return i18n.Errorf("토큰 이전 실패: %w", err)
+	}
+	return unsetKey(tokenKey)
+}
+
+// unsetKey 설정 파일에서 키 제거 ("profiles.work"처럼 점으로 구분한 중첩 키 허용)
+// viper는 키 삭제를 지원하지 않으므로 나머지 설정으로 파일을 다시 쓰고 읽는다.
+func unsetKey(key string) error {
+	settings := viper.AllSettings()
+	parts := strings.Split(key, ".")
+	parent := settings
+	for _, part := range parts[:len(parts)-1] {
+		child, ok := parent[part].(map[string]interface{})
+		if !ok {
+			return nil
 		}
+		parent = child
+	}
+	delete(parent, parts[len(parts)-1])
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return i18n.Errorf("설정 변환 실패: %w", err)
 	}
-	return viper.GetString("github_token")
+
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(configPath, data, 0600); err != nil {
+		return i18n.Errorf("설정 파일 저장 실패: %w", err)
+	}
+
+	return viper.ReadInConfig()
+}
+
+// GetSigningKey 템플릿 서명에 사용할 키 이름 조회
+func GetSigningKey() string {
+	if !initialized {
+		if err := InitConfig(); err != nil {
+			return "default"
+		}
+	}
+	if name := viper.GetString("signing_key"); name != "" {
+		return name
+	}
+	return "default"
+}
+
+// GetConfigDir 설정 디렉토리 경로 조회
+func GetConfigDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, configDir), nil
 }
 
 // GetConfigPath 설정 파일 경로 조회
@@ -75,4 +334,54 @@ func GetConfigPath() (string, error) {
 		return "", err
 	}
 	return filepath.Join(home, configDir, configFile), nil
-} 
\ No newline at end of file
+}
+
+// GetRuleInclude 규칙 파일로 취급할 파일 패턴 조회
+func GetRuleInclude() []string {
+	return listSetting("rules_include")
+}
+
+// GetRuleExclude 규칙 파일에서 제외할 파일 패턴 조회
+func GetRuleExclude() []string {
+	return listSetting("rules_exclude")
+}
+
+// DefaultRuleExclude 기본 제외 패턴 조회 (백업 파일, 버전 정보 등)
+func DefaultRuleExclude() []string {
+	return append([]string(nil), defaultRuleExclude...)
+}
+
+// GetSecretPatterns 업로드 전 비밀 정보 검사에 추가로 사용할 정규식 조회
+func GetSecretPatterns() []string {
+	return listSetting("secret_patterns")
+}
+
+// GetEncryptionKeyFile 템플릿 암호화에 사용할 키 파일 경로 조회 (없으면 암호 사용)
+func GetEncryptionKeyFile() string {
+	if !initialized {
+		if err := InitConfig(); err != nil {
+			return ""
+		}
+	}
+	return viper.GetString("encryption_key_file")
+}
+
+// GetOAuthBaseURL OAuth 기기 인증에 사용할 서버 주소 조회 (기본값: https://github.com)
+func GetOAuthBaseURL() string {
+	if !initialized {
+		if err := InitConfig(); err != nil {
+			return ""
+		}
+	}
+	return viper.GetString("oauth_base_url")
+}
+
+// GetOAuthClientID OAuth 기기 인증에 사용할 OAuth 앱 클라이언트 ID 조회
+func GetOAuthClientID() string {
+	if !initialized {
+		if err := InitConfig(); err != nil {
+			return ""
+		}
+	}
+	return viper.GetString("oauth_client_id")
+}
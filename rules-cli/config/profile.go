@@ -0,0 +1,226 @@
+package config
+
+import (
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+const (
+	// DefaultProfile 프로필을 지정하지 않았을 때 사용하는 프로필
+	DefaultProfile = "default"
+	// DefaultBackend 템플릿 저장소 기본값
+	DefaultBackend = "gist"
+	// profileEnv 사용할 프로필을 지정하는 환경 변수
+	profileEnv = "CURSORRULES_PROFILE"
+)
+
+// Backends 지원하는 템플릿 저장소
+var Backends = []string{DefaultBackend}
+
+// profileNamePattern 프로필 이름 형식 (설정 파일 키로 쓰이므로 소문자만 허용)
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// profileOverride --profile 옵션으로 지정한 프로필
+var profileOverride string
+
+// Profile 계정별 설정
+type Profile struct {
+	Name         string `json:"-" mapstructure:"-"`
+	Backend      string `json:"backend" mapstructure:"backend"`                       // 템플릿 저장소 (gist)
+	Namespace    string `json:"namespace,omitempty" mapstructure:"namespace"`         // 템플릿 이름 앞에 붙일 기본 네임스페이스
+	TokenCommand string `json:"token_command,omitempty" mapstructure:"token_command"` // 프로필 전용 토큰 명령
+	APIURL       string `json:"api_url,omitempty" mapstructure:"api_url"`             // GitHub Enterprise API 주소 (비어 있으면 github.com)
+	UploadURL    string `json:"upload_url,omitempty" mapstructure:"upload_url"`       // GitHub Enterprise 업로드 주소 (비어 있으면 API 호스트의 /api/uploads/)
+}
+
+// IsGitHubCom 프로필이 github.com을 사용하는지 확인
+func (p Profile) IsGitHubCom() bool {
+	if p.APIURL == "" {
+		return true
+	}
+	u, err := url.Parse(p.APIURL)
+	return err == nil && strings.EqualFold(u.Hostname(), "api.github.com")
+}
+
+// SetProfileOverride 명령줄에서 지정한 프로필 설정 (빈 문자열이면 무시)
+func SetProfileOverride(name string) {
+	profileOverride = name
+}
+
+// ActiveProfile 현재 사용할 프로필 이름과 선택된 경로
+// 순서: --profile 옵션 → CURSORRULES_PROFILE 환경 변수 → profile use로 지정한 프로필 → default
+func ActiveProfile() (string, string) {
+	if profileOverride != "" {
+		return profileOverride, i18n.T("--profile 옵션")
+	}
+	if env := os.Getenv(profileEnv); env != "" {
+		return env, i18n.Sprintf("%s 환경 변수", profileEnv)
+	}
+	if !initialized {
+		if err := InitConfig(); err != nil {
+			return DefaultProfile, i18n.T("기본값")
+		}
+	}
+	if current := viper.GetString("current_profile"); current != "" {
+		return current, i18n.T("설정 파일")
+	}
+	return DefaultProfile, i18n.T("기본값")
+}
+
+// GetActiveProfile 현재 사용할 프로필 설정 조회
+// default 프로필은 설정 파일에 없어도 기본값으로 존재한다.
+func GetActiveProfile() (Profile, error) {
+	name, _ := ActiveProfile()
+	profile, ok := GetProfile(name)
+	if !ok {
+		return profile, clierr.New(clierr.NotFound, "프로필 '%s'이(가) 없습니다. 'cursorrules profile list'로 확인하세요", name)
+	}
+	return profile, nil
+}
+
+// GetProfile 프로필 설정 조회
+func GetProfile(name string) (Profile, bool) {
+	profiles := loadProfiles()
+	profile, ok := profiles[name]
+	return profile, ok
+}
+
+// ListProfiles 모든 프로필 (이름순)
+func ListProfiles() []Profile {
+	profiles := loadProfiles()
+	list := make([]Profile, 0, len(profiles))
+	for _, profile := range profiles {
+		list = append(list, profile)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// AddProfile 프로필 추가 (같은 이름이 있으면 덮어씀)
+func AddProfile(profile Profile) error {
+	if !profileNamePattern.MatchString(profile.Name) {
+		return i18n.Errorf("프로필 이름은 영문 소문자, 숫자, '-', '_'만 사용할 수 있습니다: %s", profile.Name)
+	}
+	if profile.Backend == "" {
+		profile.Backend = stringSetting("backend")
+	}
+	if !isBackend(profile.Backend) {
+		return i18n.Errorf("지원하지 않는 저장소입니다: %s (%s)", profile.Backend, strings.Join(Backends, ", "))
+	}
+	for _, u := range []string{profile.APIURL, profile.UploadURL} {
+		if u == "" {
+			continue
+		}
+		if parsed, err := url.Parse(u); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return i18n.Errorf("올바른 URL이 아닙니다: %s", u)
+		}
+	}
+	if !initialized {
+		if err := InitConfig(); err != nil {
+			return err
+		}
+	}
+
+	viper.Set("profiles."+profile.Name, map[string]interface{}{
+		"backend":       profile.Backend,
+		"namespace":     profile.Namespace,
+		"token_command": profile.TokenCommand,
+		"api_url":       profile.APIURL,
+		"upload_url":    profile.UploadURL,
+	})
+	return viper.WriteConfig()
+}
+
+// RemoveProfile 프로필과 프로필에 저장된 토큰 삭제
+func RemoveProfile(name string) error {
+	if _, ok := GetProfile(name); !ok {
+		return clierr.New(clierr.NotFound, "프로필 '%s'이(가) 없습니다", name)
+	}
+
+	store, err := GetSecretStore()
+	if err != nil {
+		return err
+	}
+	if err := store.Delete(profileTokenKey(name)); err != nil {
+		return i18n.Errorf("토큰 삭제 실패: %w", err)
+	}
+
+	if viper.GetString("current_profile") == name {
+		if err := unsetKey("current_profile"); err != nil {
+			return err
+		}
+	}
+	if viper.IsSet("profiles." + name) {
+		return unsetKey("profiles." + name)
+	}
+	return nil
+}
+
+// UseProfile 기본으로 사용할 프로필 지정
+func UseProfile(name string) error {
+	if _, ok := GetProfile(name); !ok {
+		return clierr.New(clierr.NotFound, "프로필 '%s'이(가) 없습니다. 'cursorrules profile add %s'로 추가하세요", name, name)
+	}
+	viper.Set("current_profile", name)
+	return viper.WriteConfig()
+}
+
+// QualifyTemplateName 현재 프로필의 네임스페이스를 붙인 템플릿 이름
+// 이미 "네임스페이스/이름" 형식이면 그대로 반환한다.
+func QualifyTemplateName(name string) string {
+	profile, err := GetActiveProfile()
+	if err != nil || profile.Namespace == "" || strings.Contains(name, "/") {
+		return name
+	}
+	return profile.Namespace + "/" + name
+}
+
+// loadProfiles 설정 파일의 프로필 목록 (default 프로필 포함)
+func loadProfiles() map[string]Profile {
+	profiles := make(map[string]Profile)
+	if !initialized {
+		if err := InitConfig(); err == nil {
+			_ = viper.UnmarshalKey("profiles", &profiles)
+		}
+	} else {
+		_ = viper.UnmarshalKey("profiles", &profiles)
+	}
+
+	if _, ok := profiles[DefaultProfile]; !ok {
+		profiles[DefaultProfile] = Profile{}
+	}
+	for name, profile := range profiles {
+		profile.Name = name
+		if profile.Backend == "" {
+			profile.Backend = stringSetting("backend")
+		}
+		profiles[name] = profile
+	}
+	return profiles
+}
+
+// profileTokenKey 프로필의 토큰을 비밀 값 저장소에 저장하는 키
+// default 프로필은 이전 버전과 같은 키를 사용한다.
+func profileTokenKey(profile string) string {
+	if profile == DefaultProfile {
+		return tokenKey
+	}
+	return tokenKey + ":" + profile
+}
+
+// isBackend 지원하는 저장소인지 확인
+func isBackend(backend string) bool {
+	for _, b := range Backends {
+		if b == backend {
+			return true
+		}
+	}
+	return false
+}
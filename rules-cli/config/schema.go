@@ -0,0 +1,174 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+// Kind 설정 값 종류
+type Kind int
+
+const (
+	// KindString 문자열
+	KindString Kind = iota
+	// KindEnum 정해진 값 중 하나
+	KindEnum
+	// KindList 문자열 목록 (쉼표로 구분하거나 여러 인자로 지정)
+	KindList
+)
+
+// Setting 설정 키 정의
+type Setting struct {
+	Key         string
+	Kind        Kind
+	Values      []string    // KindEnum에서 허용하는 값
+	Default     interface{} // 설정하지 않았을 때의 값
+	Project     bool        // 프로젝트 설정 파일에 저장할 수 있는지 여부
+	Description string
+}
+
+// Schema config 명령으로 다룰 수 있는 설정 키 목록
+// 토큰과 프로필은 각각 auth, profile 명령으로 관리한다.
+var Schema = []Setting{
+	{Key: "backend", Kind: KindEnum, Values: Backends, Default: DefaultBackend, Project: true, Description: "프로필에 저장소를 지정하지 않았을 때 사용할 저장소"},
+	{Key: "visibility", Kind: KindEnum, Values: []string{VisibilitySecret, VisibilityPublic}, Default: VisibilitySecret, Description: "새로 업로드하는 템플릿의 공개 범위 (전역 설정만 가능)"},
+	{Key: "conflict_policy", Kind: KindEnum, Values: []string{ConflictPrompt, ConflictOverwrite, ConflictMerge, ConflictAbort}, Default: ConflictPrompt, Project: true, Description: "설치 시 로컬 파일과 충돌할 때의 처리 방식"},
+	{Key: "editor", Kind: KindString, Default: "", Description: "config edit에서 사용할 편집기 (기본값: $VISUAL, $EDITOR)"},
+	{Key: "output", Kind: KindEnum, Values: []string{OutputTable, OutputJSON, OutputYAML}, Default: OutputTable, Project: true, Description: "명령 결과 출력 형식"},
+	{Key: "color", Kind: KindEnum, Values: []string{ColorAuto, ColorAlways, ColorNever}, Default: ColorAuto, Project: true, Description: "색상 출력 여부"},
+	{Key: "language", Kind: KindString, Default: "auto", Project: true, Description: "메시지 언어 (auto는 LC_ALL, LC_MESSAGES, LANG을 따름)"},
+	{Key: "rules_include", Kind: KindList, Default: defaultRuleInclude, Project: true, Description: "규칙 파일로 취급할 파일 패턴"},
+	{Key: "rules_exclude", Kind: KindList, Default: defaultRuleExclude, Project: true, Description: "규칙 파일에서 제외할 파일 패턴"},
+	{Key: "secret_patterns", Kind: KindList, Default: []string{}, Project: true, Description: "업로드 전 추가로 검사할 비밀 정보 정규식"},
+	{Key: "signing_key", Kind: KindString, Default: "default", Description: "업로드 시 사용할 서명 키 이름"},
+	{Key: "encryption_key_file", Kind: KindString, Default: "", Description: "암호화 업로드에 사용할 키 파일"},
+	{Key: "secret_store", Kind: KindEnum, Values: []string{"auto", "keyring", "file"}, Default: "auto", Description: "토큰 저장 방식"},
+	{Key: "token_command", Kind: KindString, Default: "", Description: "토큰을 출력하는 외부 명령"},
+	{Key: "oauth_client_id", Kind: KindString, Default: "", Description: "기기 인증 로그인에 사용할 OAuth 앱 클라이언트 ID (기본값 없음, auth login --device에 필요)"},
+	{Key: "oauth_base_url", Kind: KindString, Default: "", Description: "기기 인증 OAuth 서버 주소"},
+}
+
+const (
+	// VisibilitySecret 비공개(secret) Gist
+	VisibilitySecret = "secret"
+	// VisibilityPublic 공개 Gist
+	VisibilityPublic = "public"
+
+	// ConflictPrompt 충돌 파일을 보여주고 덮어쓸지 확인
+	ConflictPrompt = "prompt"
+	// ConflictOverwrite 확인 없이 덮어쓰기 (--force와 같음)
+	ConflictOverwrite = "overwrite"
+	// ConflictMerge 로컬 파일 유지 (--merge와 같음)
+	ConflictMerge = "merge"
+	// ConflictAbort 충돌이 있으면 설치 중단
+	ConflictAbort = "abort"
+
+	// OutputTable 사람이 읽기 위한 표 형식
+	OutputTable = "table"
+	// OutputJSON JSON 형식
+	OutputJSON = "json"
+	// OutputYAML YAML 형식
+	OutputYAML = "yaml"
+
+	// ColorAuto 터미널일 때만 색상 사용
+	ColorAuto = "auto"
+	// ColorAlways 항상 색상 사용
+	ColorAlways = "always"
+	// ColorNever 색상 사용 안 함
+	ColorNever = "never"
+)
+
+// LookupSetting 설정 키 정의 조회
+func LookupSetting(key string) (Setting, error) {
+	for _, setting := range Schema {
+		if setting.Key == key {
+			return setting, nil
+		}
+	}
+
+	keys := make([]string, 0, len(Schema))
+	for _, setting := range Schema {
+		keys = append(keys, setting.Key)
+	}
+	sort.Strings(keys)
+	return Setting{}, clierr.New(clierr.Validation, "알 수 없는 설정 키입니다: %s (사용 가능: %s)", key, strings.Join(keys, ", "))
+}
+
+// Parse 명령줄 인자를 설정 값으로 변환하고 검증
+func (s Setting) Parse(args []string) (interface{}, error) {
+	switch s.Kind {
+	case KindList:
+		var values []string
+		for _, arg := range args {
+			for _, value := range strings.Split(arg, ",") {
+				if value = strings.TrimSpace(value); value != "" {
+					values = append(values, value)
+				}
+			}
+		}
+		return values, nil
+	}
+
+	if len(args) != 1 {
+		return nil, i18n.Errorf("'%s'에는 값을 하나만 지정할 수 있습니다", s.Key)
+	}
+	value := strings.TrimSpace(args[0])
+
+	if s.Kind == KindEnum {
+		for _, allowed := range s.Values {
+			if value == allowed {
+				return value, nil
+			}
+		}
+		return nil, i18n.Errorf("'%s'의 값은 %s 중 하나여야 합니다: %s", s.Key, strings.Join(s.Values, ", "), value)
+	}
+	return value, nil
+}
+
+// Validate 설정 파일에서 읽은 값이 정의에 맞는지 확인
+func (s Setting) Validate(value interface{}) error {
+	switch s.Kind {
+	case KindList:
+		if _, ok := value.([]string); ok {
+			return nil
+		}
+		list, ok := value.([]interface{})
+		if !ok {
+			return i18n.Errorf("'%s'의 값은 문자열 목록이어야 합니다", s.Key)
+		}
+		for _, item := range list {
+			if _, ok := item.(string); !ok {
+				return i18n.Errorf("'%s'의 값은 문자열 목록이어야 합니다", s.Key)
+			}
+		}
+		return nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return i18n.Errorf("'%s'의 값은 문자열이어야 합니다", s.Key)
+	}
+	_, err := s.Parse([]string{str})
+	return err
+}
+
+// FormatValue 설정 값을 출력용 문자열로 변환
+func FormatValue(value interface{}) string {
+	switch v := value.(type) {
+	case []string:
+		return strings.Join(v, ",")
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = fmt.Sprint(item)
+		}
+		return strings.Join(parts, ",")
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,308 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+const (
+	// ProjectConfigFile 프로젝트 루트의 프로젝트 설정 파일 이름
+	ProjectConfigFile = ".cursorrules-cli.json"
+
+	// ScopeProject 프로젝트 설정 파일의 값
+	ScopeProject = "project"
+	// ScopeGlobal 전역 설정 파일의 값
+	ScopeGlobal = "global"
+	// ScopeDefault 기본값
+	ScopeDefault = "default"
+)
+
+// managedKeys 다른 명령(auth, profile)이 관리하는 전역 설정 키
+var managedKeys = map[string]bool{
+	tokenKey:          true,
+	"profiles":        true,
+	"current_profile": true,
+}
+
+// SettingValue 설정 키의 현재 값과 출처
+type SettingValue struct {
+	Setting
+	Value interface{}
+	Scope string
+}
+
+// ProjectConfigPath 현재 프로젝트의 설정 파일 경로
+func ProjectConfigPath() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", i18n.Errorf("작업 디렉토리를 찾을 수 없습니다: %w", err)
+	}
+	return filepath.Join(dir, ProjectConfigFile), nil
+}
+
+// warnedSettings 이미 경고한 잘못된 설정 값 (같은 경고를 반복하지 않기 위해)
+var warnedSettings = make(map[string]bool)
+
+// GetSetting 설정 값과 출처 조회
+// 프로젝트 설정 → 전역 설정 → 기본값 순서로 찾는다.
+// 설정 파일의 값이 올바르지 않으면 경고를 출력하고 다음 순서의 값을 사용한다.
+func GetSetting(key string) (SettingValue, error) {
+	setting, err := LookupSetting(key)
+	if err != nil {
+		return SettingValue{}, err
+	}
+
+	project, err := loadProjectSettings()
+	if err != nil && setting.Project {
+		return SettingValue{}, err
+	}
+	if value, ok := project[key]; ok {
+		switch {
+		case !setting.Project:
+			warnInvalidSetting(ScopeProject, key, i18n.Errorf("'%s'은(는) 프로젝트 설정에 저장할 수 없습니다", key))
+		case setting.Validate(value) != nil:
+			warnInvalidSetting(ScopeProject, key, setting.Validate(value))
+		default:
+			return SettingValue{Setting: setting, Value: value, Scope: ScopeProject}, nil
+		}
+	}
+
+	if !initialized {
+		if err := InitConfig(); err != nil {
+			return SettingValue{}, err
+		}
+	}
+	if viper.IsSet(key) {
+		value := viper.Get(key)
+		if err := setting.Validate(value); err != nil {
+			warnInvalidSetting(ScopeGlobal, key, err)
+		} else {
+			return SettingValue{Setting: setting, Value: value, Scope: ScopeGlobal}, nil
+		}
+	}
+
+	return SettingValue{Setting: setting, Value: setting.Default, Scope: ScopeDefault}, nil
+}
+
+// warnInvalidSetting 설정 파일의 잘못된 값을 무시한다고 한 번만 경고
+func warnInvalidSetting(scope, key string, err error) {
+	if warnedSettings[scope+":"+key] {
+		return
+	}
+	warnedSettings[scope+":"+key] = true
+	i18n.Fprintf(os.Stderr, "경고: %s 설정의 '%s' 값을 무시합니다: %v\n", scope, key, err)
+}
+
+// ListSettings 모든 설정 키의 현재 값
+func ListSettings() ([]SettingValue, error) {
+	values := make([]SettingValue, 0, len(Schema))
+	for _, setting := range Schema {
+		value, err := GetSetting(setting.Key)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// SetSetting 설정 값 저장 (project가 true면 프로젝트 설정 파일에 저장)
+func SetSetting(key string, args []string, project bool) error {
+	setting, err := LookupSetting(key)
+	if err != nil {
+		return err
+	}
+	value, err := setting.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if project {
+		if !setting.Project {
+			return i18n.Errorf("'%s'은(는) 프로젝트 설정에 저장할 수 없습니다", key)
+		}
+		settings, err := loadProjectSettings()
+		if err != nil {
+			return err
+		}
+		settings[key] = value
+		return saveProjectSettings(settings)
+	}
+
+	if !initialized {
+		if err := InitConfig(); err != nil {
+			return err
+		}
+	}
+	viper.Set(key, value)
+	return viper.WriteConfig()
+}
+
+// UnsetSetting 설정 값 삭제 (기본값으로 되돌림)
+func UnsetSetting(key string, project bool) error {
+	if _, err := LookupSetting(key); err != nil {
+		return err
+	}
+
+	if project {
+		settings, err := loadProjectSettings()
+		if err != nil {
+			return err
+		}
+		if _, ok := settings[key]; !ok {
+			return nil
+		}
+		delete(settings, key)
+		return saveProjectSettings(settings)
+	}
+
+	if !initialized {
+		if err := InitConfig(); err != nil {
+			return err
+		}
+	}
+	return unsetKey(key)
+}
+
+// ValidateFile 설정 파일 내용 검증 (알 수 없는 키나 잘못된 값이 있으면 오류)
+func ValidateFile(path string, project bool) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return i18n.Errorf("설정 파일을 읽을 수 없습니다: %w", err)
+	}
+
+	var settings map[string]interface{}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return i18n.Errorf("설정 파일이 올바른 JSON이 아닙니다: %w", err)
+	}
+
+	for key, value := range settings {
+		if !project && managedKeys[key] {
+			continue
+		}
+		setting, err := LookupSetting(key)
+		if err != nil {
+			return err
+		}
+		if project && !setting.Project {
+			return i18n.Errorf("'%s'은(는) 프로젝트 설정에 저장할 수 없습니다", key)
+		}
+		if err := setting.Validate(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadProjectSettings 프로젝트 설정 파일 로드 (없으면 빈 설정)
+func loadProjectSettings() (map[string]interface{}, error) {
+	settings := make(map[string]interface{})
+
+	path, err := ProjectConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return settings, nil
+		}
+		return nil, i18n.Errorf("프로젝트 설정 파일을 읽을 수 없습니다: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, i18n.Errorf("프로젝트 설정 파일 파싱 실패 (%s): %w", path, err)
+	}
+	return settings, nil
+}
+
+// saveProjectSettings 프로젝트 설정 파일 저장
+func saveProjectSettings(settings map[string]interface{}) error {
+	path, err := ProjectConfigPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return i18n.Errorf("프로젝트 설정 변환 실패: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return i18n.Errorf("프로젝트 설정 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// stringSetting 문자열 설정 값 (조회 실패 시 기본값)
+func stringSetting(key string) string {
+	value, err := GetSetting(key)
+	if err != nil {
+		setting, _ := LookupSetting(key)
+		return FormatValue(setting.Default)
+	}
+	return FormatValue(value.Value)
+}
+
+// listSetting 문자열 목록 설정 값 (조회 실패 시 기본값)
+func listSetting(key string) []string {
+	value, err := GetSetting(key)
+	if err != nil {
+		setting, _ := LookupSetting(key)
+		value.Value = setting.Default
+	}
+
+	switch v := value.Value.(type) {
+	case []string:
+		return v
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			list = append(list, fmt.Sprint(item))
+		}
+		return list
+	}
+	return nil
+}
+
+// GetVisibility 새로 업로드하는 템플릿의 공개 범위 (secret, public)
+func GetVisibility() string {
+	return stringSetting("visibility")
+}
+
+// GetConflictPolicy 설치 시 충돌 처리 방식 (prompt, overwrite, merge, abort)
+func GetConflictPolicy() string {
+	return stringSetting("conflict_policy")
+}
+
+// GetEditor config edit에서 사용할 편집기
+func GetEditor() string {
+	if editor := stringSetting("editor"); editor != "" {
+		return editor
+	}
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := os.Getenv(env); editor != "" {
+			return editor
+		}
+	}
+	return "vi"
+}
+
+// GetOutputFormat 명령 결과 출력 형식 (table, json, yaml)
+func GetOutputFormat() string {
+	return stringSetting("output")
+}
+
+// GetColor 색상 출력 여부 설정 (auto, always, never)
+func GetColor() string {
+	return stringSetting("color")
+}
+
+// GetLanguage 메시지 언어 설정 (auto, ko, en 등)
+func GetLanguage() string {
+	return stringSetting("language")
+}
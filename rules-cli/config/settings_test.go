@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGetSetting(t *testing.T) {
+	setupConfig(t, `{
+  "output": "xml",
+  "color": "never",
+  "conflict_policy": "merge",
+  "rules_include": ["*.mdc"],
+  "visibility": "public"
+}`)
+
+	project := t.TempDir()
+	t.Chdir(project)
+	projectSettings := `{
+  "conflict_policy": "sometimes",
+  "color": "always",
+  "visibility": "secret",
+  "rules_exclude": "*.bak"
+}`
+	if err := os.WriteFile(filepath.Join(project, ProjectConfigFile), []byte(projectSettings), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		key   string
+		value interface{}
+		scope string
+	}{
+		{"output", OutputTable, ScopeDefault},               // 전역 값이 잘못되면 기본값
+		{"color", ColorAlways, ScopeProject},                // 올바른 프로젝트 값이 우선
+		{"conflict_policy", ConflictMerge, ScopeGlobal},     // 프로젝트 값이 잘못되면 전역 값
+		{"visibility", VisibilityPublic, ScopeGlobal},       // 프로젝트 설정에 둘 수 없는 키는 무시
+		{"rules_exclude", defaultRuleExclude, ScopeDefault}, // 목록이 아닌 값은 무시
+		{"rules_include", []interface{}{"*.mdc"}, ScopeGlobal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, err := GetSetting(tt.key)
+			if err != nil {
+				t.Fatalf("GetSetting(%q): %v", tt.key, err)
+			}
+			if got.Scope != tt.scope || !reflect.DeepEqual(got.Value, tt.value) {
+				t.Errorf("GetSetting(%q) = %v (%s), want %v (%s)", tt.key, got.Value, got.Scope, tt.value, tt.scope)
+			}
+		})
+	}
+
+	// 잘못된 값이 있어도 설정을 고칠 수 있어야 한다
+	if err := UnsetSetting("output", false); err != nil {
+		t.Fatalf("UnsetSetting(): %v", err)
+	}
+	if err := SetSetting("rules_include", []string{"*.mdc,*.md"}, false); err != nil {
+		t.Fatalf("SetSetting(): %v", err)
+	}
+	if got := listSetting("rules_include"); !reflect.DeepEqual(got, []string{"*.mdc", "*.md"}) {
+		t.Errorf("listSetting(rules_include) = %v", got)
+	}
+	if err := SetSetting("visibility", []string{VisibilityPublic}, true); err == nil {
+		t.Error("SetSetting(visibility, project) = nil error, want error")
+	}
+}
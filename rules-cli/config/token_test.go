@@ -0,0 +1,106 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// setupConfig 임시 홈 디렉토리에 전역 설정 파일을 만들고 설정을 다시 로드
+func setupConfig(t *testing.T, settings string) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+
+	if err := os.MkdirAll(filepath.Join(home, configDir), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, configDir, configFile), []byte(settings), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	viper.Reset()
+	initialized = false
+	warnedSettings = make(map[string]bool)
+	if err := InitConfig(); err != nil {
+		t.Fatal(err)
+	}
+	return home
+}
+
+func TestResolveToken(t *testing.T) {
+	t.Setenv("CURSORRULES_TOKEN", "")
+	t.Setenv("GITHUB_TOKEN", "")
+	setupConfig(t, `{
+  "secret_store": "file",
+  "token_command": "echo global-command",
+  "profiles": {
+    "work": {"token_command": "echo work-command"},
+    "personal": {},
+    "ghe": {"api_url": "https://ghe.example.com/api/v3/"},
+    "ghe-stored": {"api_url": "https://ghe.example.com/api/v3/"}
+  }
+}`)
+	t.Cleanup(func() { SetProfileOverride("") })
+
+	for profile, token := range map[string]string{"default": "stored-default", "work": "stored-work", "ghe-stored": "stored-ghe"} {
+		SetProfileOverride(profile)
+		if err := SaveToken(token); err != nil {
+			t.Fatalf("SaveToken(%s): %v", profile, err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		profile string
+		env     string
+		want    string
+		source  TokenSource
+	}{
+		{"저장한 토큰이 환경 변수보다 우선", "default", "env-token", "stored-default", TokenSourceFile},
+		{"프로필 명령이 저장한 토큰보다 우선", "work", "env-token", "work-command", TokenSourceProfileCommand},
+		{"프로필 토큰이 없으면 환경 변수", "personal", "env-token", "env-token", "env:CURSORRULES_TOKEN"},
+		{"환경 변수도 없으면 공통 명령", "personal", "", "global-command", TokenSourceCommand},
+		{"다른 호스트에는 공통 토큰을 보내지 않음", "ghe", "env-token", "", TokenSourceNone},
+		{"다른 호스트에는 프로필 토큰만 사용", "ghe-stored", "env-token", "stored-ghe", TokenSourceFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetProfileOverride(tt.profile)
+			t.Setenv("CURSORRULES_TOKEN", tt.env)
+
+			token, source, err := ResolveToken()
+			if err != nil {
+				t.Fatalf("ResolveToken(): %v", err)
+			}
+			if token != tt.want || source != tt.source {
+				t.Errorf("ResolveToken() = %q, %q, want %q, %q", token, source, tt.want, tt.source)
+			}
+		})
+	}
+}
+
+func TestTokenSourceLabel(t *testing.T) {
+	tests := []struct {
+		source TokenSource
+		want   string
+	}{
+		{TokenSourceNone, "없음"},
+		{TokenSourceProfileCommand, "프로필의 token_command"},
+		{TokenSourceFile, "암호화 파일"},
+		{"env:GITHUB_TOKEN", "환경 변수 GITHUB_TOKEN"},
+		{"unknown", "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.source), func(t *testing.T) {
+			if got := tt.source.Label(); got != tt.want {
+				t.Errorf("%q.Label() = %q, want %q", tt.source, got, tt.want)
+			}
+		})
+	}
+}
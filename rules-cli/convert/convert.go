@@ -0,0 +1,99 @@
+package convert
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+// Format 다른 AI 어시스턴트의 지침 파일 형식
+type Format interface {
+	// Name 형식 이름 (--format 값)
+	Name() string
+	// Lossy 변환 시 손실되는 정보 설명
+	Lossy() string
+	// Export 템플릿을 프로젝트 루트 기준 경로별 파일 내용으로 변환
+	Export(template *models.Template) (map[string]string, error)
+	// Import 프로젝트 디렉토리의 지침 파일을 템플릿으로 변환
+	Import(projectDir string) (*models.Template, error)
+}
+
+var formats = map[string]Format{}
+
+// register 형식 등록
+func register(format Format) {
+	formats[format.Name()] = format
+}
+
+func init() {
+	register(newSingleFileFormat("claude", "CLAUDE.md", "CLAUDE.md"))
+	register(newSingleFileFormat("agents", "AGENTS.md", "AGENTS.md"))
+	register(newSingleFileFormat("windsurf", ".windsurfrules", "Windsurf Rules"))
+	register(newSingleFileFormat("cline", ".clinerules", "Cline Rules"))
+	register(&copilotFormat{})
+}
+
+// Get 이름으로 형식 조회
+func Get(name string) (Format, error) {
+	format, ok := formats[strings.ToLower(name)]
+	if !ok {
+		return nil, i18n.Errorf("지원하지 않는 형식입니다: %s (지원 형식: %s)", name, strings.Join(Names(), ", "))
+	}
+	return format, nil
+}
+
+// Names 지원하는 형식 이름 목록
+func Names() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RuleFiles 템플릿에서 지침으로 변환할 수 있는 규칙 파일과 그 외 파일을 분리
+// 규칙 파일은 경로 순으로 정렬된다.
+func RuleFiles(template *models.Template) ([]models.Rule, []string) {
+	var rules []models.Rule
+	var others []string
+	for _, rule := range template.Files {
+		if isRuleFile(rule.Path) {
+			rules = append(rules, rule)
+		} else {
+			others = append(others, rule.Path)
+		}
+	}
+
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Path < rules[j].Path })
+	sort.Strings(others)
+	return rules, others
+}
+
+// isRuleFile 지침 내용을 담은 규칙 파일인지 확인
+func isRuleFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".mdc", ".md":
+		return true
+	}
+	return filepath.Base(path) == ".cursorrules"
+}
+
+// ruleTitle 규칙 제목 (설명이 없으면 파일 이름 사용)
+func ruleTitle(rule models.Rule, meta models.RuleMeta) string {
+	if meta.Description != "" {
+		return meta.Description
+	}
+	name := filepath.Base(rule.Path)
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
+
+// slug 규칙 경로를 파일 이름에 쓸 수 있는 형태로 변환
+func slug(path string) string {
+	path = strings.TrimSuffix(filepath.ToSlash(path), filepath.Ext(path))
+	path = strings.TrimPrefix(path, ".")
+	return strings.ReplaceAll(path, "/", "-")
+}
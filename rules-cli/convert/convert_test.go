@@ -0,0 +1,203 @@
+package convert
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tinysolver/rules-cli/models"
+)
+
+// sourceTemplate 내보내기 테스트용 템플릿
+func sourceTemplate() *models.Template {
+	template := models.NewTemplate("test", "")
+	template.AddFile("always.mdc", models.FormatRuleContent(
+		models.RuleMeta{Description: "항상 적용", AlwaysApply: true}, "Always follow this.\n"), "always.mdc")
+	template.AddFile("frontend/react.mdc", models.FormatRuleContent(
+		models.RuleMeta{Description: `Say "hi" \ back`, Globs: []string{"*.tsx", "src/components/**"}}, "Use hooks.\n\n```tsx\nconst x = 1\n```\n"), "frontend/react.mdc")
+	template.AddFile("manual.mdc", models.FormatRuleContent(
+		models.RuleMeta{Description: "필요할 때만"}, "Only on request.\n"), "manual.mdc")
+	template.AddFile("notes.md", "Plain notes.\n", "notes.md")
+	template.AddFile("assets/logo.png", "\x89PNG", "assets/logo.png")
+	return template
+}
+
+// writeFiles 내보낸 파일을 프로젝트 디렉토리에 저장
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for path, content := range files {
+		full := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		format    string
+		wantFiles []string
+	}{
+		{format: "agents", wantFiles: []string{"AGENTS.md"}},
+		{format: "claude", wantFiles: []string{"CLAUDE.md"}},
+		{format: "windsurf", wantFiles: []string{".windsurfrules"}},
+		{format: "cline", wantFiles: []string{".clinerules"}},
+		{format: "copilot", wantFiles: []string{".github/copilot-instructions.md", ".github/instructions/frontend-react.instructions.md"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			format, err := Get(tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			source := sourceTemplate()
+
+			files, err := format.Export(source)
+			if err != nil {
+				t.Fatalf("Export(): %v", err)
+			}
+			var paths []string
+			for path := range files {
+				paths = append(paths, path)
+			}
+			sort.Strings(paths)
+			if strings.Join(paths, ",") != strings.Join(tt.wantFiles, ",") {
+				t.Errorf("Export() files = %v, want %v", paths, tt.wantFiles)
+			}
+
+			dir := t.TempDir()
+			writeFiles(t, dir, files)
+			imported, err := format.Import(dir)
+			if err != nil {
+				t.Fatalf("Import(): %v", err)
+			}
+
+			rules, skipped := RuleFiles(source)
+			if strings.Join(skipped, ",") != "assets/logo.png" {
+				t.Errorf("skipped = %v, want [assets/logo.png]", skipped)
+			}
+			if len(imported.Files) != len(rules) {
+				t.Errorf("Import() files = %v, want %d rules", templateKeys(imported), len(rules))
+			}
+			for _, rule := range rules {
+				got, ok := imported.Files[rule.Path]
+				if !ok {
+					t.Errorf("Import() missing %s (got %v)", rule.Path, templateKeys(imported))
+					continue
+				}
+				wantMeta, wantBody := models.ParseRuleContent(rule.Content)
+				gotMeta, gotBody := models.ParseRuleContent(got.Content)
+				if gotMeta.Description != wantMeta.Description || gotMeta.AlwaysApply != wantMeta.AlwaysApply ||
+					strings.Join(gotMeta.Globs, ",") != strings.Join(wantMeta.Globs, ",") {
+					t.Errorf("%s meta = %+v, want %+v", rule.Path, gotMeta, wantMeta)
+				}
+				if strings.TrimSpace(gotBody) != strings.TrimSpace(wantBody) {
+					t.Errorf("%s body = %q, want %q", rule.Path, gotBody, wantBody)
+				}
+			}
+
+			if format.Lossy() == "" {
+				t.Error("Lossy() is empty")
+			}
+		})
+	}
+}
+
+// templateKeys 템플릿 파일 키 (정렬)
+func templateKeys(template *models.Template) []string {
+	keys := make([]string, 0, len(template.Files))
+	for key := range template.Files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestExportWithoutRules(t *testing.T) {
+	template := models.NewTemplate("test", "")
+	template.AddFile("logo.png", "\x89PNG", "logo.png")
+	for _, name := range Names() {
+		format, _ := Get(name)
+		if _, err := format.Export(template); err == nil {
+			t.Errorf("%s Export() without rules: want error", name)
+		}
+	}
+}
+
+func TestParseAttrs(t *testing.T) {
+	attrs := parseAttrs(` path="a \"b\".mdc" description="line\nnext \\ end" globs="*.ts,*.tsx" broken="\q"`)
+	want := map[string]string{
+		"path":        `a "b".mdc`,
+		"description": "line\nnext \\ end",
+		"globs":       "*.ts,*.tsx",
+	}
+	if len(attrs) != len(want) {
+		t.Errorf("parseAttrs() = %v, want %v", attrs, want)
+	}
+	for key, value := range want {
+		if attrs[key] != value {
+			t.Errorf("parseAttrs()[%s] = %q, want %q", key, attrs[key], value)
+		}
+	}
+}
+
+func TestMarkerRoundTrip(t *testing.T) {
+	meta := models.RuleMeta{Description: "quote \" and -- and \\ and\nnewline", Globs: []string{"*.go"}, AlwaysApply: true}
+	marker := renderMarker("dir/rule.mdc", meta)
+
+	match := markerPattern.FindStringSubmatch(marker)
+	if match == nil {
+		t.Fatalf("markerPattern does not match %q", marker)
+	}
+	attrs := parseAttrs(match[1])
+	if attrs["path"] != "dir/rule.mdc" || attrs["description"] != meta.Description || attrs["globs"] != "*.go" || attrs["alwaysApply"] != "true" {
+		t.Errorf("parseAttrs(%q) = %v", marker, attrs)
+	}
+}
+
+func TestParseDocumentEscapingPath(t *testing.T) {
+	content := "# CLAUDE.md\n\n" +
+		`<!-- cursorrules path="../../etc/evil.mdc" description="a" globs="" alwaysApply="true" -->` + "\n## a\n\nA\n\n" +
+		`<!-- cursorrules path="/abs/evil.mdc" description="b" globs="" alwaysApply="true" -->` + "\n## b\n\nB\n\n" +
+		`<!-- cursorrules path="sub/../ok.mdc" description="c" globs="" alwaysApply="true" -->` + "\n## c\n\nC\n"
+
+	template := models.NewTemplate("claude", "")
+	parseDocument(template, content, "claude.mdc")
+
+	want := []string{"claude-1.mdc", "claude-2.mdc", "ok.mdc"}
+	if got := templateKeys(template); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("parseDocument() files = %v, want %v", got, want)
+	}
+}
+
+func TestImportScopedEscapingPath(t *testing.T) {
+	content := "---\napplyTo: \"*.ts\"\n---\n" +
+		`<!-- cursorrules path="../evil.mdc" description="" globs="*.ts" alwaysApply="false" -->` + "\nBody\n"
+
+	template := models.NewTemplate("copilot", "")
+	importScoped(template, content, "ts.mdc")
+
+	if got := templateKeys(template); strings.Join(got, ",") != "ts.mdc" {
+		t.Errorf("importScoped() files = %v, want [ts.mdc]", got)
+	}
+}
+
+func TestParseDocumentWithoutMarkers(t *testing.T) {
+	template := models.NewTemplate("agents", "")
+	parseDocument(template, "# Team rules\r\n\r\nBe nice.\r\n", "agents.mdc")
+
+	rule, ok := template.Files["agents.mdc"]
+	if !ok {
+		t.Fatalf("parseDocument() files = %v, want [agents.mdc]", templateKeys(template))
+	}
+	meta, body := models.ParseRuleContent(rule.Content)
+	if !meta.AlwaysApply || strings.Contains(body, "\r") || !strings.Contains(body, "Be nice.") {
+		t.Errorf("parseDocument() = %+v, %q", meta, body)
+	}
+}
@@ -0,0 +1,125 @@
+package convert
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+const (
+	copilotInstructionsFile = ".github/copilot-instructions.md"
+	copilotInstructionsDir  = ".github/instructions"
+	copilotScopedSuffix     = ".instructions.md"
+)
+
+// copilotFormat GitHub Copilot 지침 형식
+// 항상 적용되는 규칙은 copilot-instructions.md에 합치고,
+// globs가 있는 규칙은 applyTo를 지정한 개별 .instructions.md 파일로 내보낸다.
+type copilotFormat struct{}
+
+func (f *copilotFormat) Name() string {
+	return "copilot"
+}
+
+func (f *copilotFormat) Lossy() string {
+	return i18n.T("globs는 applyTo로 옮겨지지만, globs 없이 필요할 때만 적용되는 규칙(alwaysApply: false)은 항상 적용되는 지침으로 바뀝니다. 규칙 외 파일은 내보내지 않습니다.")
+}
+
+func (f *copilotFormat) Export(template *models.Template) (map[string]string, error) {
+	rules, _ := RuleFiles(template)
+	if len(rules) == 0 {
+		return nil, i18n.Errorf("내보낼 규칙 파일이 없습니다")
+	}
+
+	files := make(map[string]string)
+	var global []models.Rule
+	for _, rule := range rules {
+		meta, body := models.ParseRuleContent(rule.Content)
+		if len(meta.Globs) == 0 {
+			global = append(global, rule)
+			continue
+		}
+
+		var b strings.Builder
+		b.WriteString("---\n")
+		fmt.Fprintf(&b, "applyTo: %s\n", strconv.Quote(strings.Join(meta.Globs, ",")))
+		if meta.Description != "" {
+			fmt.Fprintf(&b, "description: %s\n", strconv.Quote(meta.Description))
+		}
+		b.WriteString("---\n")
+		b.WriteString(renderMarker(rule.Path, meta))
+		b.WriteString(strings.TrimSpace(body))
+		b.WriteString("\n")
+
+		path := filepath.ToSlash(filepath.Join(copilotInstructionsDir, slug(rule.Path)+copilotScopedSuffix))
+		files[path] = b.String()
+	}
+
+	if len(global) > 0 {
+		files[copilotInstructionsFile] = renderDocument("Copilot Instructions", global)
+	}
+
+	return files, nil
+}
+
+func (f *copilotFormat) Import(projectDir string) (*models.Template, error) {
+	template := models.NewTemplate(f.Name(), "")
+
+	content, err := os.ReadFile(filepath.Join(projectDir, copilotInstructionsFile))
+	if err == nil {
+		parseDocument(template, string(content), "copilot.mdc")
+	} else if !os.IsNotExist(err) {
+		return nil, i18n.Errorf("파일 읽기 실패: %w", err)
+	}
+
+	matches, err := filepath.Glob(filepath.Join(projectDir, copilotInstructionsDir, "*"+copilotScopedSuffix))
+	if err != nil {
+		return nil, i18n.Errorf("지침 파일 검색 실패: %w", err)
+	}
+	for _, path := range matches {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, i18n.Errorf("파일 읽기 실패: %w", err)
+		}
+		importScoped(template, string(data), strings.TrimSuffix(filepath.Base(path), copilotScopedSuffix)+".mdc")
+	}
+
+	if len(template.Files) == 0 {
+		return nil, i18n.Errorf("%s 또는 %s 파일을 찾을 수 없습니다", copilotInstructionsFile, copilotInstructionsDir)
+	}
+	return template, nil
+}
+
+// importScoped applyTo가 지정된 지침 파일을 규칙으로 변환
+func importScoped(template *models.Template, content, defaultPath string) {
+	fields, body, _ := models.ParseFrontmatter(content)
+	meta := models.RuleMeta{
+		Description: unquoteValue(fields["description"]),
+		Globs:       models.ParseGlobs(unquoteValue(fields["applyTo"])),
+	}
+
+	path := defaultPath
+	if loc := markerPattern.FindStringSubmatchIndex(body); loc != nil && loc[0] == 0 {
+		attrs := parseAttrs(body[loc[2]:loc[3]])
+		if restored := markerPath(attrs); restored != "" {
+			path = restored
+		}
+		meta.AlwaysApply = attrs["alwaysApply"] == "true"
+		body = body[loc[1]:]
+	}
+
+	template.AddFile(path, restoreContent(path, meta, strings.TrimSpace(body)+"\n"), path)
+}
+
+// unquoteValue frontmatter 값의 따옴표 제거
+func unquoteValue(value string) string {
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		return unquoted
+	}
+	return strings.Trim(value, `'"`)
+}
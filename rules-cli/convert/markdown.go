@@ -0,0 +1,226 @@
+package convert
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+const (
+	// scopeNotePrefix glob을 지원하지 않는 형식에서 적용 대상을 안내하는 문구
+	scopeNotePrefix = "> 적용 대상: "
+)
+
+var (
+	// markerPattern 내보낸 섹션의 원본 규칙 정보를 담은 주석
+	markerPattern = regexp.MustCompile(`(?m)^<!-- cursorrules((?: \w+="(?:[^"\\]|\\.)*")*) -->\n?`)
+	// attrPattern 주석 안의 속성
+	attrPattern = regexp.MustCompile(`(\w+)=("(?:[^"\\]|\\.)*")`)
+)
+
+// singleFileFormat 모든 규칙을 하나의 마크다운 파일로 합치는 형식
+// (CLAUDE.md, AGENTS.md, .windsurfrules, .clinerules)
+type singleFileFormat struct {
+	name  string
+	path  string
+	title string
+}
+
+func newSingleFileFormat(name, path, title string) *singleFileFormat {
+	return &singleFileFormat{name: name, path: path, title: title}
+}
+
+func (f *singleFileFormat) Name() string {
+	return f.name
+}
+
+func (f *singleFileFormat) Lossy() string {
+	return i18n.Sprintf("%s는 파일 단위 적용 범위를 지원하지 않아 globs는 안내 문구로만 남고, 모든 규칙이 항상 적용됩니다. 규칙 외 파일은 내보내지 않습니다.", f.path)
+}
+
+func (f *singleFileFormat) Export(template *models.Template) (map[string]string, error) {
+	rules, _ := RuleFiles(template)
+	if len(rules) == 0 {
+		return nil, i18n.Errorf("내보낼 규칙 파일이 없습니다")
+	}
+
+	return map[string]string{
+		f.path: renderDocument(f.title, rules),
+	}, nil
+}
+
+func (f *singleFileFormat) Import(projectDir string) (*models.Template, error) {
+	path := filepath.Join(projectDir, f.path)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, i18n.Errorf("%s 파일을 찾을 수 없습니다: %w", f.path, err)
+	}
+
+	template := models.NewTemplate(f.name, "")
+
+	// .clinerules는 여러 마크다운 파일을 담은 디렉토리일 수도 있다
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, i18n.Errorf("디렉토리 읽기 실패: %w", err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+				continue
+			}
+			content, err := os.ReadFile(filepath.Join(path, entry.Name()))
+			if err != nil {
+				return nil, i18n.Errorf("파일 읽기 실패: %w", err)
+			}
+			parseDocument(template, string(content), slug(entry.Name())+".mdc")
+		}
+		return template, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, i18n.Errorf("파일 읽기 실패: %w", err)
+	}
+	parseDocument(template, string(content), f.name+".mdc")
+	return template, nil
+}
+
+// renderDocument 규칙 목록을 하나의 마크다운 문서로 변환
+func renderDocument(title string, rules []models.Rule) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", title)
+	for _, rule := range rules {
+		b.WriteString("\n")
+		b.WriteString(renderSection(rule))
+	}
+	return b.String()
+}
+
+// renderSection 규칙 하나를 원본 정보 주석이 포함된 마크다운 섹션으로 변환
+func renderSection(rule models.Rule) string {
+	meta, body := models.ParseRuleContent(rule.Content)
+
+	var b strings.Builder
+	b.WriteString(renderMarker(rule.Path, meta))
+	fmt.Fprintf(&b, "## %s\n\n", ruleTitle(rule, meta))
+	if len(meta.Globs) > 0 {
+		b.WriteString(scopeNotePrefix + formatGlobs(meta.Globs) + "\n\n")
+	}
+	b.WriteString(strings.TrimSpace(body))
+	b.WriteString("\n")
+	return b.String()
+}
+
+// renderMarker 원본 규칙 정보를 HTML 주석으로 기록 (다시 가져올 때 사용)
+func renderMarker(path string, meta models.RuleMeta) string {
+	return fmt.Sprintf("<!-- cursorrules path=%s description=%s globs=%s alwaysApply=%s -->\n",
+		strconv.Quote(filepath.ToSlash(path)),
+		strconv.Quote(meta.Description),
+		strconv.Quote(strings.Join(meta.Globs, ",")),
+		strconv.Quote(strconv.FormatBool(meta.AlwaysApply)))
+}
+
+// parseDocument 마크다운 문서를 규칙으로 분리해 템플릿에 추가
+// 이 도구가 내보낸 문서는 주석을 기준으로 원래 규칙을 복원하고,
+// 그 외 문서는 항상 적용되는 하나의 규칙(defaultPath)으로 가져온다.
+func parseDocument(template *models.Template, content, defaultPath string) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	markers := markerPattern.FindAllStringSubmatchIndex(content, -1)
+	if len(markers) == 0 {
+		meta := models.RuleMeta{AlwaysApply: true}
+		template.AddFile(defaultPath, models.FormatRuleContent(meta, content), defaultPath)
+		return
+	}
+
+	for i, marker := range markers {
+		end := len(content)
+		if i+1 < len(markers) {
+			end = markers[i+1][0]
+		}
+
+		attrs := parseAttrs(content[marker[2]:marker[3]])
+		meta := models.RuleMeta{
+			Description: attrs["description"],
+			Globs:       models.ParseGlobs(attrs["globs"]),
+			AlwaysApply: attrs["alwaysApply"] == "true",
+		}
+		body := stripGeneratedLines(content[marker[1]:end])
+
+		rulePath := markerPath(attrs)
+		if rulePath == "" {
+			rulePath = fmt.Sprintf("%s-%d.mdc", strings.TrimSuffix(defaultPath, ".mdc"), i+1)
+		}
+		template.AddFile(rulePath, restoreContent(rulePath, meta, body), rulePath)
+	}
+}
+
+// markerPath 주석에 기록된 원본 규칙 경로
+// 다른 사람이 만든 문서의 주석이 규칙 디렉토리 밖을 가리키면(절대 경로, ..) 사용하지 않고 빈 문자열을 반환한다.
+func markerPath(attrs map[string]string) string {
+	raw := attrs["path"]
+	slashed := filepath.ToSlash(raw)
+	cleaned := path.Clean(slashed)
+	if raw == "" || path.IsAbs(slashed) || filepath.IsAbs(raw) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return ""
+	}
+	return cleaned
+}
+
+// restoreContent 규칙 형식에 맞게 내용 복원 (.mdc만 frontmatter 사용)
+func restoreContent(path string, meta models.RuleMeta, body string) string {
+	if filepath.Ext(path) == ".mdc" {
+		return models.FormatRuleContent(meta, body)
+	}
+	return body
+}
+
+// stripGeneratedLines 내보낼 때 추가한 제목과 적용 대상 안내 제거
+func stripGeneratedLines(section string) string {
+	lines := strings.Split(section, "\n")
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "## ") {
+		lines = lines[1:]
+	}
+
+	var kept []string
+	for _, line := range lines {
+		if strings.HasPrefix(line, scopeNotePrefix) && len(kept) == 0 {
+			continue
+		}
+		if strings.TrimSpace(line) == "" && len(kept) == 0 {
+			continue
+		}
+		kept = append(kept, line)
+	}
+
+	return strings.TrimSpace(strings.Join(kept, "\n")) + "\n"
+}
+
+// parseAttrs 주석 속성 파싱
+func parseAttrs(raw string) map[string]string {
+	attrs := make(map[string]string)
+	for _, match := range attrPattern.FindAllStringSubmatch(raw, -1) {
+		value, err := strconv.Unquote(match[2])
+		if err != nil {
+			continue
+		}
+		attrs[match[1]] = value
+	}
+	return attrs
+}
+
+// formatGlobs glob 목록을 안내 문구용으로 변환
+func formatGlobs(globs []string) string {
+	quoted := make([]string, len(globs))
+	for i, glob := range globs {
+		quoted[i] = "`" + glob + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
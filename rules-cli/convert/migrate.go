@@ -0,0 +1,230 @@
+package convert
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/tinysolver/rules-cli/models"
+)
+
+// Section 마크다운 문서의 제목 단위 구역
+type Section struct {
+	Title string // 제목 (제목 앞 내용이면 빈 문자열)
+	Body  string // 제목 줄을 포함한 내용
+}
+
+// MigratedRule 레거시 .cursorrules에서 분리된 규칙
+type MigratedRule struct {
+	Path string          // 규칙 디렉토리 기준 경로
+	Meta models.RuleMeta // 생성된 frontmatter
+	Body string          // 규칙 본문
+}
+
+var (
+	headingPattern = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*#*\s*$`)
+	// extensionPattern 본문에서 언급된 파일 확장자 (예: *.ts, .tsx 파일)
+	extensionPattern = regexp.MustCompile(`(?:^|[\s(*\x60'"])\.(tsx?|jsx?|mjs|py|go|rs|java|kt|rb|php|css|scss|less|html|vue|svelte|sql|ya?ml|sh|swift|cs|cpp|c|h)\b`)
+	// directoryPattern 본문에서 언급된 디렉토리 (예: src/components/)
+	directoryPattern = regexp.MustCompile(`(?:^|[\s(\x60'"])((?:[A-Za-z0-9_.-]+/)+)(?:[\s)\x60'",.]|$)`)
+)
+
+// languageGlobs 언어/프레임워크 이름별 추천 glob
+var languageGlobs = map[string][]string{
+	"typescript": {"**/*.ts", "**/*.tsx"},
+	"javascript": {"**/*.js", "**/*.jsx"},
+	"react":      {"**/*.tsx", "**/*.jsx"},
+	"python":     {"**/*.py"},
+	"golang":     {"**/*.go"},
+	"rust":       {"**/*.rs"},
+	"java":       {"**/*.java"},
+	"kotlin":     {"**/*.kt"},
+	"ruby":       {"**/*.rb"},
+	"swift":      {"**/*.swift"},
+	"vue":        {"**/*.vue"},
+	"svelte":     {"**/*.svelte"},
+	"css":        {"**/*.css", "**/*.scss"},
+	"sql":        {"**/*.sql"},
+}
+
+// MigrateLegacy 레거시 .cursorrules 내용을 제목 단위의 .mdc 규칙으로 분리
+// 특정 파일 형식이나 디렉토리를 언급하는 구역에는 globs를 추천하고,
+// 그렇지 않은 구역은 항상 적용되는 규칙으로 만든다.
+func MigrateLegacy(content string) []MigratedRule {
+	var rules []MigratedRule
+	used := make(map[string]bool)
+
+	for i, section := range SplitSections(content) {
+		body := strings.TrimSpace(section.Body)
+		if body == "" {
+			continue
+		}
+
+		title := section.Title
+		if title == "" {
+			title = leadingTitle(body)
+		}
+
+		name := slugTitle(title)
+		if name == "" {
+			name = fmt.Sprintf("section-%d", i+1)
+		}
+		for base, n := name, 2; used[name]; n++ {
+			name = fmt.Sprintf("%s-%d", base, n)
+		}
+		used[name] = true
+
+		globs := SuggestGlobs(body)
+		rules = append(rules, MigratedRule{
+			Path: name + ".mdc",
+			Meta: models.RuleMeta{
+				Description: title,
+				Globs:       globs,
+				AlwaysApply: len(globs) == 0,
+			},
+			Body: body + "\n",
+		})
+	}
+
+	return rules
+}
+
+// SplitSections 마크다운 문서를 제목 기준으로 분리
+// 가장 높은 수준의 제목이 문서 제목 하나뿐이면 그 다음 수준으로 분리한다.
+// 코드 블록 안의 '#'은 제목으로 취급하지 않는다.
+func SplitSections(content string) []Section {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	levels := headingLevels(lines)
+	level := 0
+	for l := 1; l <= 6; l++ {
+		if levels[l] == 0 {
+			continue
+		}
+		if levels[l] == 1 && hasDeeperHeading(levels, l) {
+			continue
+		}
+		level = l
+		break
+	}
+
+	if level == 0 {
+		return []Section{{Body: content}}
+	}
+
+	var sections []Section
+	current := Section{}
+	var buf []string
+	inFence := false
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+		}
+		if !inFence {
+			if m := headingPattern.FindStringSubmatch(line); m != nil && len(m[1]) == level {
+				current.Body = strings.Join(buf, "\n")
+				sections = append(sections, current)
+				current = Section{Title: m[2]}
+				buf = nil
+			}
+		}
+		buf = append(buf, line)
+	}
+	current.Body = strings.Join(buf, "\n")
+	sections = append(sections, current)
+
+	return sections
+}
+
+// leadingTitle 첫 구역의 제목 (문서 제목이 있으면 사용, 없으면 "overview")
+func leadingTitle(body string) string {
+	firstLine, _, _ := strings.Cut(body, "\n")
+	if m := headingPattern.FindStringSubmatch(firstLine); m != nil {
+		return m[2]
+	}
+	return "overview"
+}
+
+// headingLevels 수준별 제목 개수 (코드 블록 제외)
+func headingLevels(lines []string) map[int]int {
+	levels := make(map[int]int)
+	inFence := false
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if m := headingPattern.FindStringSubmatch(line); m != nil {
+			levels[len(m[1])]++
+		}
+	}
+	return levels
+}
+
+// hasDeeperHeading 주어진 수준보다 낮은 수준의 제목이 있는지 확인
+func hasDeeperHeading(levels map[int]int, level int) bool {
+	for l := level + 1; l <= 6; l++ {
+		if levels[l] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// SuggestGlobs 본문에서 언급된 파일 형식과 디렉토리를 바탕으로 glob 추천
+func SuggestGlobs(body string) []string {
+	seen := make(map[string]bool)
+	var globs []string
+	add := func(glob string) {
+		if !seen[glob] {
+			seen[glob] = true
+			globs = append(globs, glob)
+		}
+	}
+
+	for _, m := range extensionPattern.FindAllStringSubmatch(body, -1) {
+		add("**/*." + m[1])
+	}
+
+	for _, word := range strings.FieldsFunc(strings.ToLower(body), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		for _, glob := range languageGlobs[word] {
+			add(glob)
+		}
+	}
+
+	for _, m := range directoryPattern.FindAllStringSubmatch(body, -1) {
+		dir := m[1]
+		// URL이나 상대 경로 표기는 제외
+		if strings.Contains(dir, "//") || strings.HasPrefix(dir, ".") {
+			continue
+		}
+		add(dir + "**")
+	}
+
+	sort.Strings(globs)
+	return globs
+}
+
+// slugTitle 제목을 파일 이름으로 변환 (한글 등 유니코드 문자는 유지)
+func slugTitle(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			dash = false
+		case !dash && b.Len() > 0:
+			b.WriteRune('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
@@ -0,0 +1,209 @@
+package convert
+
+import (
+	"strings"
+	"testing"
+)
+
+// sectionTitles 구역 제목 목록
+func sectionTitles(sections []Section) []string {
+	titles := []string{}
+	for _, section := range sections {
+		titles = append(titles, section.Title)
+	}
+	return titles
+}
+
+func TestSplitSections(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string // 구역 제목 (제목 앞 내용은 "")
+	}{
+		{
+			name:    "H1 문서 제목 하나면 H2로 분리",
+			content: "# Project Rules\n\nIntro\n\n## Style\n\nA\n\n## Testing\n\nB\n",
+			want:    []string{"", "Style", "Testing"},
+		},
+		{
+			name:    "H1이 여러 개면 H1로 분리",
+			content: "# One\n\nA\n\n## Sub\n\n# Two\n\nB\n",
+			want:    []string{"", "One", "Two"},
+		},
+		{
+			name:    "제목 앞 내용",
+			content: "Preamble\n\n## A\n\nx\n",
+			want:    []string{"", "A"},
+		},
+		{
+			name:    "코드 블록 안의 #은 제목이 아님",
+			content: "## Shell\n\n```sh\n# comment\n## not a heading\n```\n\n## Python\n\n```python\n# comment\n```\n",
+			want:    []string{"", "Shell", "Python"},
+		},
+		{
+			name:    "제목 없는 문서",
+			content: "Just some rules.\nNo headings here.\n",
+			want:    []string{""},
+		},
+		{
+			name:    "CRLF 줄바꿈",
+			content: "## A\r\n\r\nx\r\n## B\r\ny\r\n",
+			want:    []string{"", "A", "B"},
+		},
+		{
+			name:    "닫는 #이 있는 제목",
+			content: "## Style ##\n\nA\n\n## Naming\n",
+			want:    []string{"", "Style", "Naming"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sectionTitles(SplitSections(tt.content))
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("SplitSections() titles = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitSectionsKeepsFencedHeadings(t *testing.T) {
+	content := "## Shell\n\n```sh\n# comment\n```\n"
+	sections := SplitSections(content)
+	if len(sections) != 2 || !strings.Contains(sections[1].Body, "# comment") {
+		t.Errorf("SplitSections() = %+v, want the fenced comment inside the Shell section", sections)
+	}
+}
+
+func TestMigrateLegacy(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantPaths []string
+		wantTitle []string
+	}{
+		{
+			name:      "문서 제목은 첫 구역 이름",
+			content:   "# Project Rules\n\nIntro\n\n## Style\n\nA\n",
+			wantPaths: []string{"project-rules.mdc", "style.mdc"},
+			wantTitle: []string{"Project Rules", "Style"},
+		},
+		{
+			name:      "제목 없는 앞부분은 overview",
+			content:   "Preamble text\n\n## Style\n\nA\n",
+			wantPaths: []string{"overview.mdc", "style.mdc"},
+			wantTitle: []string{"overview", "Style"},
+		},
+		{
+			name:      "앞부분만 있는 문서",
+			content:   "Always write tests.\nKeep functions small.\n",
+			wantPaths: []string{"overview.mdc"},
+			wantTitle: []string{"overview"},
+		},
+		{
+			name:      "중복 제목",
+			content:   "## Style\n\nA\n\n## Style\n\nB\n\n## Style\n\nC\n",
+			wantPaths: []string{"style.mdc", "style-2.mdc", "style-3.mdc"},
+			wantTitle: []string{"Style", "Style", "Style"},
+		},
+		{
+			name:      "한글 제목",
+			content:   "## 코드 스타일\n\nA\n\n## 테스트 & 리뷰!\n\nB\n",
+			wantPaths: []string{"코드-스타일.mdc", "테스트-리뷰.mdc"},
+			wantTitle: []string{"코드 스타일", "테스트 & 리뷰!"},
+		},
+		{
+			name:      "파일 이름으로 쓸 수 없는 제목",
+			content:   "## ***\n\nA\n",
+			wantPaths: []string{"section-2.mdc"},
+			wantTitle: []string{"***"},
+		},
+		{
+			name:      "빈 문서",
+			content:   "\n\n",
+			wantPaths: []string{},
+			wantTitle: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paths, titles := []string{}, []string{}
+			for _, rule := range MigrateLegacy(tt.content) {
+				paths = append(paths, rule.Path)
+				titles = append(titles, rule.Meta.Description)
+				if !strings.HasSuffix(rule.Body, "\n") || strings.TrimSpace(rule.Body) == "" {
+					t.Errorf("%s body = %q", rule.Path, rule.Body)
+				}
+			}
+			if strings.Join(paths, "|") != strings.Join(tt.wantPaths, "|") {
+				t.Errorf("MigrateLegacy() paths = %q, want %q", paths, tt.wantPaths)
+			}
+			if strings.Join(titles, "|") != strings.Join(tt.wantTitle, "|") {
+				t.Errorf("MigrateLegacy() titles = %q, want %q", titles, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestMigrateLegacyGlobs(t *testing.T) {
+	rules := MigrateLegacy("## General\n\nBe concise.\n\n## Frontend\n\nUse strict mode in *.ts files.\n")
+	if len(rules) != 2 {
+		t.Fatalf("MigrateLegacy() = %+v, want 2 rules", rules)
+	}
+	if !rules[0].Meta.AlwaysApply || len(rules[0].Meta.Globs) != 0 {
+		t.Errorf("general meta = %+v, want alwaysApply without globs", rules[0].Meta)
+	}
+	if rules[1].Meta.AlwaysApply || strings.Join(rules[1].Meta.Globs, ",") != "**/*.ts" {
+		t.Errorf("frontend meta = %+v, want globs **/*.ts", rules[1].Meta)
+	}
+}
+
+func TestSuggestGlobs(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{name: "확장자", body: "Name *.ts files in camelCase.", want: []string{"**/*.ts"}},
+		{name: "여러 확장자", body: "Applies to .tsx and `.py` files (and .go).", want: []string{"**/*.go", "**/*.py", "**/*.tsx"}},
+		{name: "디렉토리", body: "Put components in src/components/ only.", want: []string{"src/components/**"}},
+		{name: "백틱 디렉토리", body: "See `app/api/` for routes.", want: []string{"app/api/**"}},
+		{name: "언어 이름", body: "Write idiomatic TypeScript.", want: []string{"**/*.ts", "**/*.tsx"}},
+		{name: "언어 이름 중복", body: "React with TypeScript.", want: []string{"**/*.jsx", "**/*.ts", "**/*.tsx"}},
+		{name: "다른 단어의 일부", body: "Use JavaScript, not Java applets.", want: []string{"**/*.java", "**/*.js", "**/*.jsx"}},
+		{name: "URL", body: "Docs: https://example.com/docs/guide/ and http://example.com/app.js", want: nil},
+		{name: "상대 경로", body: "Run ./scripts/build/ before ../shared/ changes.", want: nil},
+		{name: "파일 이름 안의 점", body: "Edit config.yaml.example and index.html.tmpl carefully.", want: nil},
+		{name: "언급 없음", body: "Keep functions small and write tests.", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SuggestGlobs(tt.body)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("SuggestGlobs(%q) = %v, want %v", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlugTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "Code Style", want: "code-style"},
+		{title: "  API / REST (v2)  ", want: "api-rest-v2"},
+		{title: "코드 스타일", want: "코드-스타일"},
+		{title: "테스트 & 리뷰!", want: "테스트-리뷰"},
+		{title: "日本語 ルール", want: "日本語-ルール"},
+		{title: "***", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := slugTitle(tt.title); got != tt.want {
+			t.Errorf("slugTitle(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
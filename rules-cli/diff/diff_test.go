@@ -0,0 +1,52 @@
+package diff
+
+import "testing"
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"같은 내용", "a\nb\n", "a\nb\n", ""},
+		{"줄바꿈 형식만 다름", "a\r\nb\r\n", "a\nb\n", "--- old\n+++ new\n"},
+		{
+			"한 줄 변경",
+			"a\nb\nc\n", "a\nx\nc\n",
+			"--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n",
+		},
+		{
+			"빈 파일에 추가",
+			"", "a\nb\n",
+			"--- old\n+++ new\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			"전체 삭제",
+			"a\n", "",
+			"--- old\n+++ new\n@@ -1,1 +0,0 @@\n-a\n",
+		},
+		{
+			"끝에 추가",
+			"a\nb\nc\nd\ne\n", "a\nb\nc\nd\ne\nf\n",
+			"--- old\n+++ new\n@@ -3,3 +3,4 @@\n c\n d\n e\n+f\n",
+		},
+		{
+			"멀리 떨어진 변경은 별도 hunk",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n", "x\n2\n3\n4\n5\n6\n7\n8\n9\ny\n",
+			"--- old\n+++ new\n@@ -1,4 +1,4 @@\n-1\n+x\n 2\n 3\n 4\n@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+y\n",
+		},
+		{
+			"가까운 변경은 하나의 hunk",
+			"1\n2\n3\n4\n5\n", "x\n2\n3\n4\ny\n",
+			"--- old\n+++ new\n@@ -1,5 +1,5 @@\n-1\n+x\n 2\n 3\n 4\n-5\n+y\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified(tt.a, tt.b, "old", "new"); got != tt.want {
+				t.Errorf("Unified() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/encryption"
+	"github.com/tinysolver/rules-cli/gist"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+	"github.com/tinysolver/rules-cli/prompt"
+)
+
+// passphraseEnv 템플릿 암호화 암호를 지정하는 환경 변수
+const passphraseEnv = "CURSORRULES_PASSPHRASE"
+
+// cachedSecrets 한 번의 실행에서 여러 템플릿을 처리할 때 암호를 다시 묻지 않도록 보관
+var cachedSecrets = make(map[string][]byte)
+
+// encryptionSecret 키 유도 방식에 맞는 암호 또는 키 파일 내용 조회
+// 암호는 환경 변수를 먼저 확인하고, 없으면 터미널에서 입력받는다.
+func encryptionSecret(kdf string, confirm bool) ([]byte, error) {
+	if secret, ok := cachedSecrets[kdf]; ok {
+		return secret, nil
+	}
+
+	var secret []byte
+	switch kdf {
+	case encryption.KDFKeyFile:
+		keyFile := config.GetEncryptionKeyFile()
+		if keyFile == "" {
+			return nil, i18n.Errorf("키 파일로 암호화된 템플릿입니다. 설정 파일의 encryption_key_file에 키 파일 경로를 지정하세요")
+		}
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, i18n.Errorf("키 파일을 읽을 수 없습니다: %w", err)
+		}
+		secret = data
+
+	case encryption.KDFPassphrase:
+		if env := os.Getenv(passphraseEnv); env != "" {
+			secret = []byte(env)
+			break
+		}
+		passphrase, err := readPassphrase("템플릿 암호")
+		if err != nil {
+			return nil, err
+		}
+		if confirm {
+			again, err := readPassphrase("템플릿 암호 확인")
+			if err != nil {
+				return nil, err
+			}
+			if again != passphrase {
+				return nil, i18n.Errorf("입력한 암호가 일치하지 않습니다")
+			}
+		}
+		secret = []byte(passphrase)
+
+	default:
+		return nil, i18n.Errorf("지원하지 않는 키 유도 방식입니다: %s", kdf)
+	}
+
+	cachedSecrets[kdf] = secret
+	return secret, nil
+}
+
+// readPassphrase 화면에 표시하지 않고 암호 입력
+// 입력을 받을 수 없으면 환경 변수로 지정하도록 안내한다.
+func readPassphrase(question string) (string, error) {
+	passphrase, err := prompt.Secret(i18n.T(question), i18n.Sprintf("%s 환경 변수로 암호를 지정하세요", passphraseEnv))
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(passphrase) == "" {
+		return "", i18n.Errorf("암호가 비어 있습니다")
+	}
+	return passphrase, nil
+}
+
+// newEncryptionKey 업로드용 새 암호화 키 생성
+// 설정에 키 파일이 지정되어 있으면 키 파일을, 아니면 암호를 사용한다.
+func newEncryptionKey() (*encryption.Key, error) {
+	kdf := encryption.KDFPassphrase
+	if config.GetEncryptionKeyFile() != "" {
+		kdf = encryption.KDFKeyFile
+	}
+
+	secret, err := encryptionSecret(kdf, true)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.NewKey(kdf, secret)
+}
+
+// encryptFiles 매니페스트와 서명을 제외한 업로드 파일 암호화
+// 매니페스트의 파일 해시는 암호문의 해시로 바꿔, 공개된 매니페스트로 평문 내용을 추측할 수 없도록 한다.
+func encryptFiles(files map[string]string, manifest *models.TemplateVersion, key *encryption.Key) error {
+	for filename, content := range files {
+		if filename == gist.ManifestFile || filename == gist.SignatureFile {
+			continue
+		}
+		sealed, err := key.Seal(filename, content)
+		if err != nil {
+			return err
+		}
+		files[filename] = sealed
+
+		if info, ok := manifest.Files[filename]; ok {
+			info.Hash = models.HashContent(sealed)
+			manifest.Files[filename] = info
+		}
+	}
+	return nil
+}
+
+// decryptFiles 매니페스트에 기록된 방식으로 다운로드한 파일 복호화
+func decryptFiles(files map[string]string, info *models.EncryptionInfo) error {
+	secret, err := encryptionSecret(info.KDF, false)
+	if err != nil {
+		return err
+	}
+	key, err := encryption.DeriveKey(*info, secret)
+	if err != nil {
+		return err
+	}
+
+	for filename, content := range files {
+		plain, err := key.Open(filename, content)
+		if err != nil {
+			return err
+		}
+		files[filename] = plain
+	}
+	return nil
+}
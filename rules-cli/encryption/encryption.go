@@ -0,0 +1,148 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+const (
+	// Scheme 파일 암호화 방식
+	Scheme = "aes-256-gcm"
+	// KDFPassphrase 암호에서 키를 유도하는 방식
+	KDFPassphrase = "pbkdf2-sha256"
+	// KDFKeyFile 키 파일에서 키를 유도하는 방식
+	KDFKeyFile = "hkdf-sha256"
+
+	// pbkdf2Iterations PBKDF2 반복 횟수
+	pbkdf2Iterations = 600000
+	// saltSize 키 유도 salt 크기
+	saltSize = 16
+	// keySize AES-256 키 크기
+	keySize = 32
+	// hkdfInfo HKDF 용도 구분 문자열
+	hkdfInfo = "cursorrules template encryption"
+	// prefix 암호화된 파일 내용 접두사
+	prefix = "cursorrules-encrypted:v1:"
+)
+
+// ErrDecrypt 암호나 키가 맞지 않거나 내용이 변조된 경우
+var ErrDecrypt = i18n.NewError("복호화 실패: 암호 또는 키가 올바르지 않거나 내용이 변조되었습니다")
+
+// Key 템플릿 파일 암호화 키
+type Key struct {
+	info models.EncryptionInfo
+	aead cipher.AEAD
+}
+
+// NewKey 새 salt로 암호화 키 생성
+// kdf가 KDFPassphrase면 secret을 암호로, KDFKeyFile이면 키 파일 내용으로 취급한다.
+func NewKey(kdf string, secret []byte) (*Key, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, i18n.Errorf("salt 생성 실패: %w", err)
+	}
+
+	info := models.EncryptionInfo{
+		Scheme: Scheme,
+		KDF:    kdf,
+		Salt:   base64.StdEncoding.EncodeToString(salt),
+	}
+	if kdf == KDFPassphrase {
+		info.Iterations = pbkdf2Iterations
+	}
+	return DeriveKey(info, secret)
+}
+
+// DeriveKey 매니페스트에 기록된 정보로 암호화 키 유도
+func DeriveKey(info models.EncryptionInfo, secret []byte) (*Key, error) {
+	if info.Scheme != Scheme {
+		return nil, i18n.Errorf("지원하지 않는 암호화 방식입니다: %s", info.Scheme)
+	}
+	if len(secret) == 0 {
+		return nil, i18n.Errorf("암호 또는 키가 비어 있습니다")
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(info.Salt)
+	if err != nil {
+		return nil, i18n.Errorf("salt 형식이 올바르지 않습니다: %w", err)
+	}
+
+	var raw []byte
+	switch info.KDF {
+	case KDFPassphrase:
+		if info.Iterations <= 0 {
+			return nil, i18n.Errorf("PBKDF2 반복 횟수가 올바르지 않습니다: %d", info.Iterations)
+		}
+		raw, err = pbkdf2.Key(sha256.New, string(secret), salt, info.Iterations, keySize)
+	case KDFKeyFile:
+		raw, err = hkdf.Key(sha256.New, secret, salt, hkdfInfo, keySize)
+	default:
+		return nil, i18n.Errorf("지원하지 않는 키 유도 방식입니다: %s", info.KDF)
+	}
+	if err != nil {
+		return nil, i18n.Errorf("키 유도 실패: %w", err)
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{info: info, aead: aead}, nil
+}
+
+// Info 매니페스트에 기록할 암호화 정보
+func (k *Key) Info() *models.EncryptionInfo {
+	info := k.info
+	return &info
+}
+
+// Seal 파일 내용 암호화
+// 파일 경로를 추가 인증 데이터로 사용해 다른 파일과 내용을 바꿔치기할 수 없도록 한다.
+func (k *Key) Seal(path, content string) (string, error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", i18n.Errorf("nonce 생성 실패: %w", err)
+	}
+
+	sealed := k.aead.Seal(nonce, nonce, []byte(content), []byte(path))
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open 암호화된 파일 내용 복호화
+func (k *Key) Open(path, content string) (string, error) {
+	if !IsEncrypted(content) {
+		return "", i18n.Errorf("'%s' 파일이 암호화되어 있지 않습니다", path)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(strings.TrimPrefix(content, prefix)))
+	if err != nil || len(sealed) < k.aead.NonceSize() {
+		return "", fmt.Errorf("'%s': %w", path, ErrDecrypt)
+	}
+
+	nonce, ciphertext := sealed[:k.aead.NonceSize()], sealed[k.aead.NonceSize():]
+	plain, err := k.aead.Open(nil, nonce, ciphertext, []byte(path))
+	if err != nil {
+		return "", fmt.Errorf("'%s': %w", path, ErrDecrypt)
+	}
+	return string(plain), nil
+}
+
+// IsEncrypted 암호화된 파일 내용인지 확인
+func IsEncrypted(content string) bool {
+	return strings.HasPrefix(content, prefix)
+}
@@ -0,0 +1,131 @@
+package encryption
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tinysolver/rules-cli/models"
+)
+
+func TestSealOpen(t *testing.T) {
+	tests := []struct {
+		name   string
+		kdf    string
+		secret string
+	}{
+		{"키 파일", KDFKeyFile, "0123456789abcdef0123456789abcdef"},
+		{"암호", KDFPassphrase, "correct horse battery staple"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := NewKey(tt.kdf, []byte(tt.secret))
+			if err != nil {
+				t.Fatalf("NewKey(): %v", err)
+			}
+
+			sealed, err := key.Seal("rules/a.mdc", "secret rule")
+			if err != nil {
+				t.Fatalf("Seal(): %v", err)
+			}
+			if !IsEncrypted(sealed) {
+				t.Fatalf("Seal() = %q, want encrypted content", sealed)
+			}
+
+			// 매니페스트에 기록된 정보로 같은 키를 다시 유도할 수 있어야 한다
+			derived, err := DeriveKey(*key.Info(), []byte(tt.secret))
+			if err != nil {
+				t.Fatalf("DeriveKey(): %v", err)
+			}
+			plain, err := derived.Open("rules/a.mdc", sealed)
+			if err != nil || plain != "secret rule" {
+				t.Errorf("Open() = %q, %v, want %q", plain, err, "secret rule")
+			}
+		})
+	}
+}
+
+func TestOpenFailure(t *testing.T) {
+	key, err := NewKey(KDFKeyFile, []byte("team key"))
+	if err != nil {
+		t.Fatalf("NewKey(): %v", err)
+	}
+	sealed, err := key.Seal("a.mdc", "secret rule")
+	if err != nil {
+		t.Fatalf("Seal(): %v", err)
+	}
+
+	wrongKey, err := DeriveKey(*key.Info(), []byte("other key"))
+	if err != nil {
+		t.Fatalf("DeriveKey(): %v", err)
+	}
+	otherSalt, err := NewKey(KDFKeyFile, []byte("team key"))
+	if err != nil {
+		t.Fatalf("NewKey(): %v", err)
+	}
+
+	tampered := []byte(sealed)
+	tampered[len(tampered)-3] ^= 1
+
+	tests := []struct {
+		name    string
+		key     *Key
+		path    string
+		content string
+		wantErr error
+	}{
+		{"다른 키", wrongKey, "a.mdc", sealed, ErrDecrypt},
+		{"다른 salt", otherSalt, "a.mdc", sealed, ErrDecrypt},
+		{"다른 경로", key, "b.mdc", sealed, ErrDecrypt},
+		{"변조된 내용", key, "a.mdc", string(tampered), ErrDecrypt},
+		{"잘린 내용", key, "a.mdc", prefix + "AAAA", ErrDecrypt},
+		{"암호화되지 않은 내용", key, "a.mdc", "plain", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plain, err := tt.key.Open(tt.path, tt.content)
+			if err == nil {
+				t.Fatalf("Open() = %q, want error", plain)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Open() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeriveKeyInvalid(t *testing.T) {
+	key, err := NewKey(KDFKeyFile, []byte("team key"))
+	if err != nil {
+		t.Fatalf("NewKey(): %v", err)
+	}
+
+	unsupported := *key.Info()
+	unsupported.Scheme = "aes-128-cbc"
+	unknownKDF := *key.Info()
+	unknownKDF.KDF = "scrypt"
+	noIterations := *key.Info()
+	noIterations.KDF = KDFPassphrase
+	noIterations.Iterations = 0
+	badSalt := *key.Info()
+	badSalt.Salt = "!!"
+
+	tests := []struct {
+		name   string
+		info   models.EncryptionInfo
+		secret string
+	}{
+		{"지원하지 않는 암호화 방식", unsupported, "team key"},
+		{"지원하지 않는 키 유도 방식", unknownKDF, "team key"},
+		{"반복 횟수 없음", noIterations, "team key"},
+		{"salt 형식 오류", badSalt, "team key"},
+		{"빈 키", *key.Info(), ""},
+	}
+
+	for _, tt := range tests {
+		if _, err := DeriveKey(tt.info, []byte(tt.secret)); err == nil {
+			t.Errorf("%s: DeriveKey() = nil error, want error", tt.name)
+		}
+	}
+}
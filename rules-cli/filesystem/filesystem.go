@@ -1,12 +1,11 @@
 package filesystem
 
 import (
-	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
-	"crypto/md5"
+	"sort"
 
+	"github.com/tinysolver/rules-cli/i18n"
 	"github.com/tinysolver/rules-cli/models"
 )
 
@@ -14,26 +13,36 @@ const (
 	rulesDir = ".cursor/rules"
 )
 
+// GetProjectDir 프로젝트 루트 디렉토리 경로 조회
+func GetProjectDir() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", i18n.Errorf("작업 디렉토리를 찾을 수 없습니다: %w", err)
+	}
+	return dir, nil
+}
+
 // GetRulesDir 규칙 디렉토리 경로 조회
 func GetRulesDir() (string, error) {
-	dir, err := os.Getwd()
+	dir, err := GetProjectDir()
 	if err != nil {
-		return "", fmt.Errorf("작업 디렉토리를 찾을 수 없습니다: %v", err)
+		return "", err
 	}
 
 	dir = filepath.Join(dir, rulesDir)
 	if err := os.MkdirAll(dir, 0755); err != nil {
-		return "", fmt.Errorf("규칙 디렉토리를 생성할 수 없습니다: %v", err)
+		return "", i18n.Errorf("규칙 디렉토리를 생성할 수 없습니다: %w", err)
 	}
 
 	return dir, nil
 }
 
 // LoadLocalTemplate 로컬 템플릿 로드
-func LoadLocalTemplate() (*models.Template, *models.TemplateVersion, error) {
+// 규칙 필터에 해당하지 않아 제외된 파일 목록을 함께 반환한다.
+func LoadLocalTemplate() (*models.Template, *models.TemplateVersion, []SkippedFile, error) {
 	rulesDir, err := GetRulesDir()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	template := &models.Template{
@@ -41,6 +50,17 @@ func LoadLocalTemplate() (*models.Template, *models.TemplateVersion, error) {
 	}
 
 	version := models.NewTemplateVersion("local", "v1.0.0")
+	filter := NewRuleFilter()
+	var skipped []SkippedFile
+
+	ignore, err := LoadIgnoreMatcher()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	projectDir, err := GetProjectDir()
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	err = filepath.Walk(rulesDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -51,20 +71,37 @@ func LoadLocalTemplate() (*models.Template, *models.TemplateVersion, error) {
 			return nil
 		}
 
-		// .mdc 파일만 처리
-		if !strings.HasSuffix(info.Name(), ".mdc") {
-			return nil
+		// 상대 경로 계산 (rulesDir 기준)
+		relPath, err := filepath.Rel(rulesDir, path)
+		if err != nil {
+			return i18n.Errorf("상대 경로 변환 실패: %w", err)
 		}
 
-		content, err := os.ReadFile(path)
+		// .cursorrulesignore 패턴은 프로젝트 루트 기준으로 적용
+		projectPath, err := filepath.Rel(projectDir, path)
 		if err != nil {
-			return fmt.Errorf("파일 읽기 실패: %v", err)
+			return i18n.Errorf("상대 경로 변환 실패: %w", err)
+		}
+		if ignored, pattern := ignore.Match(projectPath); ignored {
+			skipped = append(skipped, SkippedFile{Path: relPath, Reason: pattern, Ignored: true})
+			return nil
 		}
 
-		// 상대 경로 계산 (rulesDir 기준)
-		relPath, err := filepath.Rel(rulesDir, path)
+		// 심볼릭 링크는 규칙 디렉토리 밖의 파일을 가리킬 수 있으므로 따라가지 않음
+		if isSymlink(info) {
+			skipped = append(skipped, SkippedFile{Path: relPath, Reason: i18n.T("심볼릭 링크")})
+			return nil
+		}
+
+		// 규칙 필터에 해당하는 파일만 처리
+		if ok, reason := filter.Match(relPath); !ok {
+			skipped = append(skipped, SkippedFile{Path: relPath, Reason: reason, Default: filter.IsDefaultExclude(relPath)})
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
 		if err != nil {
-			return fmt.Errorf("상대 경로 변환 실패: %v", err)
+			return i18n.Errorf("파일 읽기 실패: %w", err)
 		}
 
 		// 파일 구조 보존을 위해 경로를 키로 사용
@@ -77,17 +114,73 @@ func LoadLocalTemplate() (*models.Template, *models.TemplateVersion, error) {
 		template.Files[relPath] = rule
 
 		// 버전 정보 추가
-		hash := fmt.Sprintf("%x", md5.Sum(content))
-		version.AddFile(relPath, info.ModTime(), hash)
+		version.AddFile(relPath, info.ModTime(), models.HashContent(string(content)))
 
 		return nil
 	})
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("템플릿 로드 실패: %v", err)
+		return nil, nil, nil, i18n.Errorf("템플릿 로드 실패: %w", err)
 	}
 
-	return template, version, nil
+	// 레거시 .cursorrules 파일 추가
+	if ignored, pattern := ignore.Match(LegacyRulesFile); ignored {
+		skipped = append(skipped, SkippedFile{Path: LegacyRulesFile, Reason: pattern, Ignored: true})
+	} else if info, err := os.Lstat(filepath.Join(projectDir, LegacyRulesFile)); err == nil && isSymlink(info) {
+		skipped = append(skipped, SkippedFile{Path: LegacyRulesFile, Reason: i18n.T("심볼릭 링크")})
+	} else if err := loadLegacyRule(template, version); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return template, version, skipped, nil
+}
+
+// isSymlink 심볼릭 링크 여부 확인
+func isSymlink(info os.FileInfo) bool {
+	return info.Mode()&os.ModeSymlink != 0
+}
+
+// loadLegacyRule 프로젝트 루트의 레거시 .cursorrules 파일을 템플릿에 추가
+func loadLegacyRule(template *models.Template, version *models.TemplateVersion) error {
+	projectDir, err := GetProjectDir()
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(projectDir, LegacyRulesFile)
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return i18n.Errorf("파일 읽기 실패: %w", err)
+	}
+
+	template.Files[LegacyRulesFile] = models.Rule{
+		Name:    LegacyRulesFile,
+		Content: string(content),
+		Path:    LegacyRulesFile,
+	}
+
+	version.AddFile(LegacyRulesFile, info.ModTime(), models.HashContent(string(content)))
+
+	return nil
+}
+
+// resolveRulePath 규칙 파일이 저장될 절대 경로 계산
+// 레거시 .cursorrules 파일은 프로젝트 루트에, 나머지는 규칙 디렉토리에 저장된다.
+// 규칙 디렉토리를 벗어나는 경로는 오류를 반환한다.
+func resolveRulePath(rulesDir string, file models.Rule) (string, error) {
+	if IsLegacyRule(file.Path) {
+		projectDir, err := GetProjectDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(projectDir, LegacyRulesFile), nil
+	}
+	return confinePath(rulesDir, file.Path)
 }
 
 // SaveLocalTemplate 로컬 템플릿 저장
@@ -97,52 +190,127 @@ func SaveLocalTemplate(template *models.Template, version *models.TemplateVersio
 		return err
 	}
 
+	// 하나라도 허용되지 않는 경로가 있으면 아무것도 저장하지 않음
+	if err := validateTemplatePaths(dir, template); err != nil {
+		return err
+	}
+
 	// 버전 정보 저장
 	if version != nil {
 		versionData, err := version.ToJSONString()
 		if err != nil {
-			return fmt.Errorf("버전 정보 변환 실패: %v", err)
+			return i18n.Errorf("버전 정보 변환 실패: %w", err)
 		}
 
 		versionPath := filepath.Join(dir, "version.json")
 		if err := os.WriteFile(versionPath, []byte(versionData), 0644); err != nil {
-			return fmt.Errorf("버전 정보 저장 실패: %v", err)
+			return i18n.Errorf("버전 정보 저장 실패: %w", err)
 		}
 	}
 
 	// 파일 저장
 	for _, file := range template.Files {
-		// .mdc 파일만 저장
-		if !strings.HasSuffix(file.Name, ".mdc") {
-			continue
-		}
-
 		// 파일 구조 보존을 위해 Path 사용
-		filePath := filepath.Join(dir, file.Path)
+		filePath, err := resolveRulePath(dir, file)
+		if err != nil {
+			return err
+		}
 		content := []byte(file.Content)
-		
+
 		// 디렉토리 생성
 		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
-			return fmt.Errorf("디렉토리 생성 실패: %v", err)
+			return i18n.Errorf("디렉토리 생성 실패: %w", err)
 		}
-		
+
 		// 기존 파일 백업
 		if _, err := os.Stat(filePath); err == nil {
 			backupPath := filePath + ".bak"
 			if err := os.Rename(filePath, backupPath); err != nil {
-				return fmt.Errorf("백업 생성 실패: %v", err)
+				return i18n.Errorf("백업 생성 실패: %w", err)
 			}
 		}
 
 		// 새 파일 저장
 		if err := os.WriteFile(filePath, content, 0644); err != nil {
-			return fmt.Errorf("파일 저장 실패: %v", err)
+			return i18n.Errorf("파일 저장 실패: %w", err)
 		}
 	}
 
 	return nil
 }
 
+// SaveProjectFiles 프로젝트 루트 기준 경로에 파일 저장
+// 기존 파일은 .bak으로 백업한다.
+func SaveProjectFiles(files map[string]string) error {
+	projectDir, err := GetProjectDir()
+	if err != nil {
+		return err
+	}
+
+	// 하나라도 프로젝트 밖을 가리키는 경로가 있으면 아무것도 저장하지 않음
+	paths := make(map[string]string, len(files))
+	var unsafe []UnsafePath
+	for path := range files {
+		filePath, err := confinePath(projectDir, path)
+		if err != nil {
+			unsafe = append(unsafe, UnsafePath{Path: path, Reason: err.Error()})
+			continue
+		}
+		paths[path] = filePath
+	}
+	if err := newUnsafePathError(unsafe); err != nil {
+		return err
+	}
+
+	for path, content := range files {
+		filePath := paths[path]
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return i18n.Errorf("디렉토리 생성 실패: %w", err)
+		}
+
+		// 기존 파일 백업
+		if _, err := os.Stat(filePath); err == nil {
+			if err := os.Rename(filePath, filePath+".bak"); err != nil {
+				return i18n.Errorf("백업 생성 실패: %w", err)
+			}
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			return i18n.Errorf("파일 저장 실패: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ChangedFiles 로컬 파일과 내용이 다른(또는 로컬에 없는) 파일만 담은 템플릿 반환
+func ChangedFiles(template *models.Template) (*models.Template, error) {
+	dir, err := GetRulesDir()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateTemplatePaths(dir, template); err != nil {
+		return nil, err
+	}
+
+	changed := models.NewTemplate(template.Name, template.Description)
+	for key, file := range template.Files {
+		filePath, err := resolveRulePath(dir, file)
+		if err != nil {
+			return nil, err
+		}
+		content, err := os.ReadFile(filePath)
+		if err == nil && string(content) == file.Content {
+			continue
+		}
+		changed.Files[key] = file
+	}
+
+	return changed, nil
+}
+
 // CheckConflicts 충돌 확인
 func CheckConflicts(template *models.Template) ([]string, error) {
 	dir, err := GetRulesDir()
@@ -150,9 +318,16 @@ func CheckConflicts(template *models.Template) ([]string, error) {
 		return nil, err
 	}
 
+	if err := validateTemplatePaths(dir, template); err != nil {
+		return nil, err
+	}
+
 	var conflicts []string
 	for _, file := range template.Files {
-		filePath := filepath.Join(dir, file.Name)
+		filePath, err := resolveRulePath(dir, file)
+		if err != nil {
+			return nil, err
+		}
 		if _, err := os.Stat(filePath); err == nil {
 			conflicts = append(conflicts, file.Name)
 		}
@@ -168,35 +343,100 @@ func MergeTemplate(template *models.Template, version *models.TemplateVersion) e
 		return err
 	}
 
+	// 하나라도 허용되지 않는 경로가 있으면 아무것도 저장하지 않음
+	if err := validateTemplatePaths(dir, template); err != nil {
+		return err
+	}
+
 	// 버전 정보 저장
 	versionData, err := version.ToJSONString()
 	if err != nil {
-		return fmt.Errorf("버전 정보 변환 실패: %v", err)
+		return i18n.Errorf("버전 정보 변환 실패: %w", err)
 	}
 
 	versionPath := filepath.Join(dir, "version.json")
 	if err := os.WriteFile(versionPath, []byte(versionData), 0644); err != nil {
-		return fmt.Errorf("버전 정보 저장 실패: %v", err)
+		return i18n.Errorf("버전 정보 저장 실패: %w", err)
 	}
 
 	// 파일 저장
 	for _, file := range template.Files {
-		filePath := filepath.Join(dir, file.Name)
+		filePath, err := resolveRulePath(dir, file)
+		if err != nil {
+			return err
+		}
 		content := []byte(file.Content)
-		
+
 		// 기존 파일 백업
 		if _, err := os.Stat(filePath); err == nil {
 			backupPath := filePath + ".bak"
 			if err := os.Rename(filePath, backupPath); err != nil {
-				return fmt.Errorf("백업 생성 실패: %v", err)
+				return i18n.Errorf("백업 생성 실패: %w", err)
 			}
 		}
 
 		// 새 파일 저장
 		if err := os.WriteFile(filePath, content, 0644); err != nil {
-			return fmt.Errorf("파일 저장 실패: %v", err)
+			return i18n.Errorf("파일 저장 실패: %w", err)
 		}
 	}
 
 	return nil
-} 
\ No newline at end of file
+}
+
+// RemoveUnchangedFiles 내용이 기록된 해시와 같은 파일만 삭제
+// 로컬에서 수정된 파일은 남겨 두고, 삭제한 파일과 남긴 파일 목록을 반환한다.
+func RemoveUnchangedFiles(hashes map[string]string) ([]string, []string, error) {
+	dir, err := GetRulesDir()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var removed, kept []string
+	for path, hash := range hashes {
+		filePath, err := resolveRulePath(dir, models.Rule{Name: path, Path: path})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, nil, i18n.Errorf("파일 읽기 실패: %w", err)
+		}
+		if models.HashContent(string(content)) != hash {
+			kept = append(kept, path)
+			continue
+		}
+
+		if err := os.Remove(filePath); err != nil {
+			return nil, nil, i18n.Errorf("파일 삭제 실패: %w", err)
+		}
+		removed = append(removed, path)
+	}
+
+	sort.Strings(removed)
+	sort.Strings(kept)
+	return removed, kept, nil
+}
+
+// ReadRuleFile 템플릿 파일 경로에 해당하는 로컬 파일 내용 조회
+// 파일이 없으면 os.IsNotExist로 확인할 수 있는 오류를 반환한다.
+func ReadRuleFile(path string) (string, error) {
+	dir, err := GetRulesDir()
+	if err != nil {
+		return "", err
+	}
+
+	filePath, err := resolveRulePath(dir, models.Rule{Name: path, Path: path})
+	if err != nil {
+		return "", err
+	}
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
@@ -0,0 +1,74 @@
+package filesystem
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadLocalTemplateSkipped(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	project := t.TempDir()
+	t.Chdir(project)
+	outside := t.TempDir()
+
+	rules := filepath.Join(project, rulesDir)
+	files := map[string]string{
+		"a.mdc":        "a",
+		"a.mdc.bak":    "backup",
+		"version.json": "{}",
+		"notes.txt":    "notes",
+	}
+	if err := os.MkdirAll(rules, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(rules, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(outside, "secret.mdc"), []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(outside, "secret.mdc"), filepath.Join(rules, "link.mdc")); err != nil {
+		t.Skipf("심볼릭 링크를 만들 수 없습니다: %v", err)
+	}
+	if err := os.Symlink(outside, filepath.Join(rules, "linked")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(outside, "secret.mdc"), filepath.Join(project, LegacyRulesFile)); err != nil {
+		t.Fatal(err)
+	}
+
+	template, _, skipped, err := LoadLocalTemplate()
+	if err != nil {
+		t.Fatalf("LoadLocalTemplate(): %v", err)
+	}
+	if len(template.Files) != 1 || template.Files["a.mdc"].Content != "a" {
+		t.Errorf("Files = %v, want only a.mdc", template.Files)
+	}
+
+	// 경로별 기본 제외 패턴 해당 여부
+	want := map[string]bool{
+		"a.mdc.bak":     true,
+		"version.json":  true,
+		"notes.txt":     false,
+		"link.mdc":      false,
+		"linked":        false,
+		LegacyRulesFile: false,
+	}
+	got := make(map[string]bool)
+	for _, file := range skipped {
+		got[file.Path] = file.Default
+	}
+	if len(got) != len(want) {
+		t.Errorf("skipped = %v, want %v", got, want)
+	}
+	for path, isDefault := range want {
+		if gotDefault, ok := got[path]; !ok || gotDefault != isDefault {
+			t.Errorf("skipped[%s] = %v (found %v), want %v", path, gotDefault, ok, isDefault)
+		}
+	}
+}
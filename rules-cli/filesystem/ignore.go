@@ -0,0 +1,194 @@
+package filesystem
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+const (
+	// IgnoreFile 업로드 등에서 제외할 규칙 파일 패턴을 담는 파일 이름
+	IgnoreFile = ".cursorrulesignore"
+)
+
+// ignoreRule gitignore 형식의 패턴 한 줄
+type ignoreRule struct {
+	pattern string         // 원본 패턴
+	source  string         // 패턴이 정의된 파일
+	negate  bool           // '!'로 시작하는 재포함 패턴 여부
+	regex   *regexp.Regexp // 변환된 정규식
+}
+
+// IgnoreMatcher .cursorrulesignore 패턴 집합
+type IgnoreMatcher struct {
+	rules []ignoreRule
+}
+
+// LoadIgnoreMatcher 전역 및 프로젝트 .cursorrulesignore 파일 로드
+// 프로젝트 파일의 패턴이 나중에 적용되므로 전역 패턴보다 우선한다.
+func LoadIgnoreMatcher() (*IgnoreMatcher, error) {
+	matcher := &IgnoreMatcher{}
+
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	if err := matcher.addFile(filepath.Join(configDir, IgnoreFile)); err != nil {
+		return nil, err
+	}
+
+	projectDir, err := GetProjectDir()
+	if err != nil {
+		return nil, err
+	}
+	if err := matcher.addFile(filepath.Join(projectDir, IgnoreFile)); err != nil {
+		return nil, err
+	}
+
+	return matcher, nil
+}
+
+// addFile 무시 패턴 파일을 읽어 패턴 추가 (파일이 없으면 무시)
+func (m *IgnoreMatcher) addFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return i18n.Errorf("무시 패턴 파일을 읽을 수 없습니다: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		m.AddPattern(scanner.Text(), path)
+	}
+	if err := scanner.Err(); err != nil {
+		return i18n.Errorf("무시 패턴 파일을 읽을 수 없습니다: %w", err)
+	}
+	return nil
+}
+
+// AddPattern gitignore 형식의 패턴 한 줄 추가
+func (m *IgnoreMatcher) AddPattern(line, source string) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return
+	}
+
+	rule := ignoreRule{pattern: line, source: source}
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+		line = line[1:]
+	}
+
+	dirOnly := strings.HasSuffix(line, "/")
+	line = strings.TrimSuffix(line, "/")
+	// 중간이나 앞에 '/'가 있으면 프로젝트 루트 기준 패턴
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	if line == "" {
+		return
+	}
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	if !anchored {
+		expr.WriteString("(?:.*/)?")
+	}
+	expr.WriteString(globToRegexp(line))
+	if dirOnly {
+		expr.WriteString("/.*$")
+	} else {
+		expr.WriteString("(?:/.*)?$")
+	}
+
+	regex, err := regexp.Compile(expr.String())
+	if err != nil {
+		return
+	}
+	rule.regex = regex
+	m.rules = append(m.rules, rule)
+}
+
+// Match 프로젝트 루트 기준 상대 경로가 무시 대상인지 확인하고, 일치한 패턴 정보를 반환
+func (m *IgnoreMatcher) Match(relPath string) (bool, string) {
+	if m == nil {
+		return false, ""
+	}
+
+	path := filepath.ToSlash(relPath)
+	ignored := false
+	matched := ""
+	for _, rule := range m.rules {
+		if !rule.regex.MatchString(path) {
+			continue
+		}
+		ignored = !rule.negate
+		matched = fmt.Sprintf("%s: %s", rule.source, rule.pattern)
+	}
+
+	if !ignored {
+		return false, ""
+	}
+	return true, matched
+}
+
+// MatchRule 템플릿 파일 경로(규칙 디렉토리 기준)가 무시 대상인지 확인
+// 레거시 .cursorrules 파일은 프로젝트 루트, 나머지는 규칙 디렉토리 아래 경로로 패턴을 적용한다.
+func (m *IgnoreMatcher) MatchRule(rulePath string) (bool, string) {
+	if IsLegacyRule(rulePath) {
+		return m.Match(LegacyRulesFile)
+	}
+	return m.Match(path.Join(rulesDir, filepath.ToSlash(rulePath)))
+}
+
+// globToRegexp gitignore 글롭 패턴을 정규식으로 변환
+func globToRegexp(pattern string) string {
+	var expr strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "/**") && i+3 == len(pattern):
+			expr.WriteString("/.*")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case c == '*':
+			expr.WriteString("[^/]*")
+		case c == '?':
+			expr.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				expr.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + class + "]")
+			i += end
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			expr.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return expr.String()
+}
@@ -0,0 +1,76 @@
+package filesystem
+
+import "testing"
+
+func TestIgnoreMatcherMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		path     string
+		want     bool
+	}{
+		{"빈 패턴", nil, ".cursor/rules/a.mdc", false},
+		{"파일 이름", []string{"draft.mdc"}, ".cursor/rules/team/draft.mdc", true},
+		{"확장자 글롭", []string{"*.tmp"}, ".cursor/rules/a.tmp", true},
+		{"글롭은 디렉토리를 넘지 않음", []string{".cursor/rules/*.mdc"}, ".cursor/rules/team/a.mdc", false},
+		{"이중 별표", []string{".cursor/rules/**/*.mdc"}, ".cursor/rules/team/deep/a.mdc", true},
+		{"루트 기준 패턴", []string{"/draft.mdc"}, ".cursor/rules/draft.mdc", false},
+		{"디렉토리 패턴", []string{"private/"}, ".cursor/rules/private/a.mdc", true},
+		{"디렉토리 패턴은 같은 이름의 파일에 적용하지 않음", []string{"private/"}, ".cursor/rules/private", false},
+		{"디렉토리 아래 전체", []string{".cursor/rules/private"}, ".cursor/rules/private/x/a.mdc", true},
+		{"재포함", []string{"*.mdc", "!keep.mdc"}, ".cursor/rules/keep.mdc", false},
+		{"재포함 뒤 다시 제외", []string{"*.mdc", "!keep.mdc", "keep.mdc"}, ".cursor/rules/keep.mdc", true},
+		{"물음표", []string{"rule?.mdc"}, ".cursor/rules/rule1.mdc", true},
+		{"문자 클래스", []string{"rule[0-9].mdc"}, ".cursor/rules/ruleA.mdc", false},
+		{"부정 문자 클래스", []string{"rule[!0-9].mdc"}, ".cursor/rules/ruleA.mdc", true},
+		{"주석", []string{"# a.mdc"}, ".cursor/rules/a.mdc", false},
+		{"이스케이프한 느낌표", []string{`\!a.mdc`}, ".cursor/rules/!a.mdc", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher := &IgnoreMatcher{}
+			for _, pattern := range tt.patterns {
+				matcher.AddPattern(pattern, IgnoreFile)
+			}
+			if got, _ := matcher.Match(tt.path); got != tt.want {
+				t.Errorf("Match(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIgnoreMatcherMatchRule(t *testing.T) {
+	matcher := &IgnoreMatcher{}
+	matcher.AddPattern("/.cursor/rules/private/", IgnoreFile)
+	matcher.AddPattern("/.cursorrules", IgnoreFile)
+
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"private/a.mdc", true},
+		{"public/a.mdc", false},
+		{".cursorrules", true},
+	}
+	for _, tt := range tests {
+		if got, _ := matcher.MatchRule(tt.path); got != tt.want {
+			t.Errorf("MatchRule(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestIgnoreMatcherReason(t *testing.T) {
+	matcher := &IgnoreMatcher{}
+	matcher.AddPattern("*.tmp", "/project/.cursorrulesignore")
+
+	ignored, reason := matcher.Match("a.tmp")
+	if !ignored || reason != "/project/.cursorrulesignore: *.tmp" {
+		t.Errorf("Match() = %v, %q", ignored, reason)
+	}
+
+	var nilMatcher *IgnoreMatcher
+	if ignored, _ := nilMatcher.Match("a.tmp"); ignored {
+		t.Error("nil matcher는 아무것도 제외하지 않아야 합니다")
+	}
+}
@@ -0,0 +1,131 @@
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+// reservedNames Windows에서 파일 이름으로 쓸 수 없는 장치 이름
+var reservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// UnsafePath 저장할 수 없는 파일 경로
+type UnsafePath struct {
+	Path   string // 템플릿에 기록된 경로
+	Reason string // 거부 사유
+}
+
+// UnsafePathError 저장 위치를 벗어나거나 사용할 수 없는 경로가 포함된 템플릿
+type UnsafePathError struct {
+	Entries []UnsafePath
+}
+
+func (e *UnsafePathError) Error() string {
+	lines := make([]string, 0, len(e.Entries))
+	for _, entry := range e.Entries {
+		lines = append(lines, fmt.Sprintf("  %s: %s", entry.Path, entry.Reason))
+	}
+	return i18n.Sprintf("허용되지 않는 파일 경로가 있어 저장하지 않았습니다:\n%s", strings.Join(lines, "\n"))
+}
+
+// confinePath 상대 경로를 root 아래의 절대 경로로 변환
+// 절대 경로, 상위 디렉토리 참조, 예약된 이름, root 밖을 가리키는 심볼릭 링크는 거부한다.
+func confinePath(root, relPath string) (string, error) {
+	if relPath == "" {
+		return "", i18n.Errorf("경로가 비어 있습니다")
+	}
+	if strings.ContainsRune(relPath, 0) {
+		return "", i18n.Errorf("경로에 NUL 문자가 있습니다")
+	}
+
+	// 어느 플랫폼에서 만든 템플릿이든 같은 기준으로 검사하도록 구분자 통일
+	slashed := strings.ReplaceAll(relPath, "\\", "/")
+	if strings.HasPrefix(slashed, "/") || filepath.IsAbs(relPath) || filepath.VolumeName(relPath) != "" ||
+		(len(slashed) >= 2 && slashed[1] == ':') {
+		return "", i18n.Errorf("절대 경로는 사용할 수 없습니다")
+	}
+
+	for _, part := range strings.Split(slashed, "/") {
+		if part == ".." {
+			return "", i18n.Errorf("상위 디렉토리(..)를 참조할 수 없습니다")
+		}
+		base, _, _ := strings.Cut(strings.TrimRight(part, ". "), ".")
+		if reservedNames[strings.ToUpper(base)] {
+			return "", i18n.Errorf("예약된 파일 이름입니다: %s", part)
+		}
+	}
+
+	cleaned := filepath.Clean(filepath.FromSlash(slashed))
+	if cleaned == "." {
+		return "", i18n.Errorf("파일 이름이 없습니다")
+	}
+	target := filepath.Join(root, cleaned)
+
+	// 이미 있는 경로 중 심볼릭 링크로 root 밖을 가리키는 것이 있는지 확인
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		realRoot = root
+	}
+	for current := target; ; current = filepath.Dir(current) {
+		if _, err := os.Lstat(current); err == nil {
+			resolved, err := filepath.EvalSymlinks(current)
+			if err != nil {
+				return "", i18n.Errorf("심볼릭 링크를 확인할 수 없습니다: %w", err)
+			}
+			if !isWithin(realRoot, resolved) {
+				return "", i18n.Errorf("심볼릭 링크가 저장 위치 밖을 가리킵니다: %s", resolved)
+			}
+			break
+		}
+		if current == root || filepath.Dir(current) == current {
+			break
+		}
+	}
+
+	return target, nil
+}
+
+// isWithin path가 root 또는 그 하위 경로인지 확인
+func isWithin(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// validateTemplatePaths 템플릿의 모든 파일 경로 검사
+// 문제가 있는 경로를 모두 모아 UnsafePathError로 반환한다.
+func validateTemplatePaths(rulesDir string, template *models.Template) error {
+	var unsafe []UnsafePath
+	for key, file := range template.Files {
+		if _, err := resolveRulePath(rulesDir, file); err != nil {
+			path := file.Path
+			if path == "" {
+				path = key
+			}
+			unsafe = append(unsafe, UnsafePath{Path: path, Reason: err.Error()})
+		}
+	}
+	return newUnsafePathError(unsafe)
+}
+
+// newUnsafePathError 경로 목록이 비어 있지 않으면 정렬된 UnsafePathError 반환
+func newUnsafePathError(unsafe []UnsafePath) error {
+	if len(unsafe) == 0 {
+		return nil
+	}
+	sort.Slice(unsafe, func(i, j int) bool { return unsafe[i].Path < unsafe[j].Path })
+	return &UnsafePathError{Entries: unsafe}
+}
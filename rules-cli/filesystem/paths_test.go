@@ -0,0 +1,65 @@
+package filesystem
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfinePath(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+
+	if err := os.Mkdir(filepath.Join(root, "inner"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "escape")); err != nil {
+		t.Skipf("심볼릭 링크를 만들 수 없습니다: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(root, "inner"), filepath.Join(root, "alias")); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string // root 기준 결과 경로 (오류가 나야 하면 빈 문자열)
+		wantErr bool
+	}{
+		{name: "파일 이름", path: "a.mdc", want: "a.mdc"},
+		{name: "하위 디렉토리", path: "team/a.mdc", want: "team/a.mdc"},
+		{name: "역슬래시 구분자", path: `team\a.mdc`, want: "team/a.mdc"},
+		{name: "중복 구분자와 현재 디렉토리", path: "./team//a.mdc", want: "team/a.mdc"},
+		{name: "점으로 시작하는 이름", path: "..hidden.mdc", want: "..hidden.mdc"},
+		{name: "root 안을 가리키는 링크", path: "alias/a.mdc", want: "alias/a.mdc"},
+		{name: "빈 경로", path: "", wantErr: true},
+		{name: "NUL 문자", path: "a\x00.mdc", wantErr: true},
+		{name: "절대 경로", path: "/etc/passwd", wantErr: true},
+		{name: "역슬래시 절대 경로", path: `\Windows\a.mdc`, wantErr: true},
+		{name: "드라이브 문자", path: "C:/a.mdc", wantErr: true},
+		{name: "상위 디렉토리", path: "../a.mdc", wantErr: true},
+		{name: "중간의 상위 디렉토리", path: "team/../../a.mdc", wantErr: true},
+		{name: "역슬래시 상위 디렉토리", path: `team\..\..\a.mdc`, wantErr: true},
+		{name: "예약된 이름", path: "CON", wantErr: true},
+		{name: "확장자가 있는 예약된 이름", path: "team/nul.mdc", wantErr: true},
+		{name: "끝에 점이 있는 예약된 이름", path: "aux.", wantErr: true},
+		{name: "현재 디렉토리만", path: ".", wantErr: true},
+		{name: "root 밖을 가리키는 링크", path: "escape/a.mdc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := confinePath(root, tt.path)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("confinePath(%q) = %q, want error", tt.path, got)
+				}
+				return
+			}
+			want := filepath.Join(root, filepath.FromSlash(tt.want))
+			if err != nil || got != want {
+				t.Errorf("confinePath(%q) = %q, %v, want %q", tt.path, got, err, want)
+			}
+		})
+	}
+}
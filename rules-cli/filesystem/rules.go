@@ -0,0 +1,73 @@
+package filesystem
+
+import (
+	"path/filepath"
+
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+const (
+	// LegacyRulesFile 프로젝트 루트에 위치하는 단일 파일 형식의 규칙 파일
+	LegacyRulesFile = ".cursorrules"
+)
+
+// RuleFilter 규칙 파일 포함/제외 패턴
+type RuleFilter struct {
+	Include []string // 규칙 파일로 취급할 패턴
+	Exclude []string // 포함 패턴에 해당하더라도 제외할 패턴
+	Default []string // 안내 없이 제외하는 기본 제외 패턴
+}
+
+// SkippedFile 로드 과정에서 제외된 파일
+type SkippedFile struct {
+	Path    string // 규칙 디렉토리 기준 상대 경로
+	Reason  string // 제외 사유
+	Ignored bool   // .cursorrulesignore 패턴에 의해 제외되었는지 여부
+	Default bool   // 기본 제외 패턴(백업 파일, 버전 정보 등)에 해당하는지 여부
+}
+
+// NewRuleFilter 설정 파일 기반 규칙 필터 생성
+func NewRuleFilter() *RuleFilter {
+	return &RuleFilter{
+		Include: config.GetRuleInclude(),
+		Exclude: config.GetRuleExclude(),
+		Default: config.DefaultRuleExclude(),
+	}
+}
+
+// Match 파일이 규칙 파일인지 확인하고, 제외되는 경우 사유를 반환
+func (f *RuleFilter) Match(relPath string) (bool, string) {
+	if matchAny(f.Exclude, relPath) {
+		return false, i18n.T("제외 패턴에 해당")
+	}
+	if !matchAny(f.Include, relPath) {
+		return false, i18n.T("지원하지 않는 파일 형식")
+	}
+	return true, ""
+}
+
+// IsDefaultExclude 기본 제외 패턴에 해당하는 파일인지 확인
+func (f *RuleFilter) IsDefaultExclude(relPath string) bool {
+	return matchAny(f.Default, relPath)
+}
+
+// IsLegacyRule 레거시 .cursorrules 파일 여부 확인
+func IsLegacyRule(path string) bool {
+	return filepath.ToSlash(path) == LegacyRulesFile
+}
+
+// matchAny 파일 이름 또는 상대 경로가 패턴 중 하나와 일치하는지 확인
+func matchAny(patterns []string, relPath string) bool {
+	slashPath := filepath.ToSlash(relPath)
+	base := filepath.Base(relPath)
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, base); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, slashPath); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package gist
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-github/v58/github"
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+// RequiredScope 템플릿 저장에 필요한 OAuth 권한
+const RequiredScope = "gist"
+
+// TokenInfo 토큰으로 확인한 GitHub 계정과 권한
+type TokenInfo struct {
+	Login string
+	// Scopes 클래식 토큰의 OAuth 권한 (X-OAuth-Scopes 헤더)
+	Scopes []string
+	// ScopesKnown 응답에 권한 헤더가 있었는지 여부 (fine-grained 토큰은 헤더가 없다)
+	ScopesKnown bool
+	// GistAccess Gist 목록을 조회할 수 있는지 여부 (권한 헤더가 없는 토큰만 실제 요청으로 확인)
+	GistAccess bool
+}
+
+// HasScope 권한 포함 여부
+func (t *TokenInfo) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckToken 현재 프로필의 GitHub에서 토큰으로 사용자 정보를 조회해 로그인 계정과 권한 확인
+func CheckToken(token string) (*TokenInfo, error) {
+	profile, err := config.GetActiveProfile()
+	if err != nil {
+		return nil, err
+	}
+	client, err := newClient(token, profile)
+	if err != nil {
+		return nil, err
+	}
+	return checkToken(client)
+}
+
+// checkToken 클라이언트의 토큰으로 로그인 계정과 권한 확인
+func checkToken(client *github.Client) (*TokenInfo, error) {
+	ctx := context.Background()
+	user, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return nil, clierr.New(clierr.Auth, "토큰이 올바르지 않거나 만료되었습니다")
+		}
+		return nil, apiError("사용자 정보 조회 실패", err)
+	}
+
+	info := &TokenInfo{Login: user.GetLogin()}
+	if header, ok := resp.Header[http.CanonicalHeaderKey("X-OAuth-Scopes")]; ok {
+		info.ScopesKnown = true
+		for _, scope := range strings.Split(strings.Join(header, ","), ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				info.Scopes = append(info.Scopes, scope)
+			}
+		}
+		info.GistAccess = info.HasScope(RequiredScope)
+		return info, nil
+	}
+
+	// 권한 헤더가 없으면 Gist 목록을 하나만 조회해 접근할 수 있는지 확인
+	opts := &github.GistListOptions{ListOptions: github.ListOptions{PerPage: 1}}
+	_, resp, err = client.Gists.List(ctx, "", opts)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound) {
+			return info, nil
+		}
+		return nil, apiError("Gist 목록 조회 실패", err)
+	}
+	info.GistAccess = true
+
+	return info, nil
+}
+
+// newClient 토큰으로 인증하는 GitHub API 클라이언트 생성
+// 프로필에 API 주소가 있으면 GitHub Enterprise Server 주소를 사용한다.
+func newClient(token string, profile config.Profile) (*github.Client, error) {
+	ts := github.BasicAuthTransport{
+		Username: "token",
+		Password: token,
+	}
+	client := github.NewClient(ts.Client())
+	if profile.APIURL == "" {
+		return client, nil
+	}
+
+	// 업로드 주소가 없으면 API 주소와 같은 호스트의 /api/uploads/ 사용
+	uploadURL := profile.UploadURL
+	if uploadURL == "" {
+		apiURL, err := url.Parse(profile.APIURL)
+		if err != nil {
+			return nil, i18n.Errorf("프로필 '%s'의 GitHub Enterprise 주소가 올바르지 않습니다: %w", profile.Name, err)
+		}
+		uploadURL = apiURL.Scheme + "://" + apiURL.Host + "/api/uploads/"
+	}
+	client, err := client.WithEnterpriseURLs(profile.APIURL, uploadURL)
+	if err != nil {
+		return nil, i18n.Errorf("프로필 '%s'의 GitHub Enterprise 주소가 올바르지 않습니다: %w", profile.Name, err)
+	}
+	return client, nil
+}
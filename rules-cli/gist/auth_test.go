@@ -0,0 +1,69 @@
+package gist
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tinysolver/rules-cli/config"
+)
+
+func TestCheckToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		scopes      []string // nil이면 권한 헤더 없음 (fine-grained 토큰)
+		userStatus  int
+		gistsStatus int
+		wantErr     bool
+		wantKnown   bool
+		wantAccess  bool
+	}{
+		{name: "gist 권한이 있는 클래식 토큰", scopes: []string{"repo, gist"}, userStatus: http.StatusOK, wantKnown: true, wantAccess: true},
+		{name: "gist 권한이 없는 클래식 토큰", scopes: []string{"repo"}, userStatus: http.StatusOK, wantKnown: true},
+		{name: "Gist에 접근할 수 있는 fine-grained 토큰", userStatus: http.StatusOK, gistsStatus: http.StatusOK, wantAccess: true},
+		{name: "Gist 권한이 없는 fine-grained 토큰", userStatus: http.StatusOK, gistsStatus: http.StatusForbidden},
+		{name: "만료된 토큰", userStatus: http.StatusUnauthorized, wantErr: true},
+		{name: "Gist 조회 서버 오류", userStatus: http.StatusOK, gistsStatus: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v3/user", func(w http.ResponseWriter, r *http.Request) {
+				if tt.scopes != nil {
+					w.Header()["X-Oauth-Scopes"] = tt.scopes
+				}
+				w.WriteHeader(tt.userStatus)
+				w.Write([]byte(`{"login":"octocat"}`))
+			})
+			mux.HandleFunc("/api/v3/gists", func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("per_page") != "1" {
+					t.Errorf("gists per_page = %q, want 1", r.URL.Query().Get("per_page"))
+				}
+				w.WriteHeader(tt.gistsStatus)
+				w.Write([]byte(`[]`))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client, err := newClient("token", config.Profile{Name: "test", APIURL: server.URL + "/api/v3/"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			info, err := checkToken(client)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("checkToken() = %+v, want error", info)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("checkToken(): %v", err)
+			}
+			if info.Login != "octocat" || info.ScopesKnown != tt.wantKnown || info.GistAccess != tt.wantAccess {
+				t.Errorf("checkToken() = %+v, want known %v, access %v", info, tt.wantKnown, tt.wantAccess)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package gist
+
+import "sync"
+
+// maxConcurrentRequests 동시에 보내는 최대 API 요청 수
+const maxConcurrentRequests = 4
+
+// forEachConcurrently 0부터 n-1까지 fetch를 동시에 실행 (한 번에 maxConcurrentRequests개까지)
+// 모두 끝날 때까지 기다린 뒤 가장 앞 순서의 오류를 반환한다.
+func forEachConcurrently(n int, fetch func(i int) error) error {
+	errs := make([]error, n)
+	slots := make(chan struct{}, maxConcurrentRequests)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			errs[i] = fetch(i)
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetGistContents 여러 Gist의 내용을 동시에 조회 (gistIDs와 같은 순서)
+func (g *GistClient) GetGistContents(gistIDs []string) ([]map[string]string, error) {
+	contents := make([]map[string]string, len(gistIDs))
+	err := forEachConcurrently(len(gistIDs), func(i int) error {
+		var err error
+		contents[i], err = g.GetGistContent(gistIDs[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
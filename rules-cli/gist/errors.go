@@ -0,0 +1,27 @@
+package gist
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/go-github/v58/github"
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+// apiError GitHub API 오류에 응답 상태에 맞는 오류 코드 지정
+// 401/403은 인증, 404는 찾을 수 없음, 그 외는 네트워크 오류로 분류한다.
+func apiError(message string, err error) error {
+	code := clierr.Network
+
+	var response *github.ErrorResponse
+	if errors.As(err, &response) && response.Response != nil {
+		switch response.Response.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			code = clierr.Auth
+		case http.StatusNotFound:
+			code = clierr.NotFound
+		}
+	}
+	return clierr.New(code, "%s: %w", i18n.T(message), err)
+}
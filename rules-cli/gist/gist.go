@@ -7,13 +7,17 @@ import (
 	"time"
 
 	"github.com/google/go-github/v58/github"
+	"github.com/tinysolver/rules-cli/clierr"
 	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/i18n"
 	"github.com/tinysolver/rules-cli/models"
 )
 
 const (
 	// GistTag Cursor Rules CLI에서 사용하는 Gist임을 식별하는 태그
 	GistTag = "[cursor-rules-cli]"
+	// emptyContent Gist API가 빈 파일을 허용하지 않아 대신 저장하는 내용
+	emptyContent = " "
 )
 
 // GistClient GitHub Gist API 클라이언트
@@ -23,17 +27,32 @@ type GistClient struct {
 
 // NewGistClient 새로운 Gist 클라이언트 생성
 func NewGistClient() (*GistClient, error) {
-	token := config.GetToken()
-	if token == "" {
-		return nil, fmt.Errorf("GitHub 토큰이 설정되지 않았습니다. 'cursorrules auth' 명령어로 토큰을 설정하세요")
+	profile, err := config.GetActiveProfile()
+	if err != nil {
+		return nil, err
+	}
+	if profile.Backend != config.DefaultBackend {
+		return nil, clierr.New(clierr.Validation, "프로필 '%s'의 저장소(%s)는 Gist 클라이언트로 사용할 수 없습니다", profile.Name, profile.Backend)
 	}
 
-	ts := github.BasicAuthTransport{
-		Username: "token",
-		Password: token,
+	token, _, err := config.ResolveToken()
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return nil, clierr.New(clierr.Auth, "GitHub 토큰이 설정되지 않았습니다. 'cursorrules auth' 명령어로 토큰을 설정하세요")
 	}
-	client := github.NewClient(ts.Client())
 
+	return NewGistClientForProfile(token, profile)
+}
+
+// NewGistClientForProfile 토큰과 프로필로 Gist 클라이언트 생성
+// 프로필의 api_url, upload_url로 GitHub Enterprise Server나 테스트 서버를 가리킬 수 있다.
+func NewGistClientForProfile(token string, profile config.Profile) (*GistClient, error) {
+	client, err := newClient(token, profile)
+	if err != nil {
+		return nil, err
+	}
 	return &GistClient{client: client}, nil
 }
 
@@ -57,7 +76,7 @@ func (g *GistClient) ListGists() ([]*github.Gist, error) {
 		Since: time.Now().Add(-24 * 365 * time.Hour), // 1년 이내의 Gist만 조회
 	})
 	if err != nil {
-		return nil, fmt.Errorf("Gist 목록 조회 실패: %v", err)
+		return nil, apiError("Gist 목록 조회 실패", err)
 	}
 
 	// Cursor Rules CLI에서 사용하는 Gist만 필터링
@@ -73,26 +92,26 @@ func (g *GistClient) ListGists() ([]*github.Gist, error) {
 
 // GistInfo Gist의 상세 정보
 type GistInfo struct {
-	ID          string            `json:"id"`
-	Description string            `json:"description"`
-	Public      bool              `json:"public"`
-	CreatedAt   time.Time         `json:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at"`
+	ID          string              `json:"id"`
+	Description string              `json:"description"`
+	Public      bool                `json:"public"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
 	Files       map[string]FileInfo `json:"files"`
-	Owner       string            `json:"owner"`
-	Version     string            `json:"version"`
+	Owner       string              `json:"owner"`
+	Version     string              `json:"version"`
 }
 
 // FileInfo Gist 파일의 상세 정보
 type FileInfo struct {
-	Filename    string    `json:"filename"`
-	Type        string    `json:"type"`
-	Language    string    `json:"language"`
-	Size        int       `json:"size"`
-	RawURL      string    `json:"raw_url"`
-	Content     string    `json:"content"`
+	Filename     string    `json:"filename"`
+	Type         string    `json:"type"`
+	Language     string    `json:"language"`
+	Size         int       `json:"size"`
+	RawURL       string    `json:"raw_url"`
+	Content      string    `json:"content"`
 	LastModified time.Time `json:"last_modified"`
-	Hash        string    `json:"hash"`
+	Hash         string    `json:"hash"`
 }
 
 // GetGistInfo Gist의 상세 정보 조회
@@ -100,7 +119,7 @@ func (g *GistClient) GetGistInfo(gistID string) (*GistInfo, error) {
 	ctx := context.Background()
 	gist, _, err := g.client.Gists.Get(ctx, gistID)
 	if err != nil {
-		return nil, fmt.Errorf("Gist 정보 조회 실패: %v", err)
+		return nil, apiError("Gist 정보 조회 실패", err)
 	}
 
 	info := &GistInfo{
@@ -123,7 +142,7 @@ func (g *GistClient) GetGistInfo(gistID string) (*GistInfo, error) {
 			RawURL:       file.GetRawURL(),
 			Content:      file.GetContent(),
 			LastModified: gist.GetUpdatedAt().Time,
-			Hash:         file.GetContent(), // 임시로 content를 hash로 사용
+			Hash:         models.HashContent(restoreEmpty(file.GetContent())),
 		}
 	}
 
@@ -135,19 +154,28 @@ func (g *GistClient) GetGistContent(gistID string) (map[string]string, error) {
 	ctx := context.Background()
 	gist, _, err := g.client.Gists.Get(ctx, gistID)
 	if err != nil {
-		return nil, fmt.Errorf("Gist 내용 조회 실패: %v", err)
+		return nil, apiError("Gist 내용 조회 실패", err)
 	}
 
 	contents := make(map[string]string)
 	for _, file := range gist.Files {
 		if file.Content != nil {
-			contents[file.GetFilename()] = *file.Content
+			contents[file.GetFilename()] = restoreEmpty(*file.Content)
 		}
 	}
 
 	return contents, nil
 }
 
+// restoreEmpty 업로드 시 빈 파일 대신 저장한 내용을 다시 빈 내용으로 변환
+// 로컬과 원격의 파일 해시가 같아지도록 한다.
+func restoreEmpty(content string) string {
+	if content == emptyContent {
+		return ""
+	}
+	return content
+}
+
 // CreateGist 새로운 Gist 생성
 func (g *GistClient) CreateGist(description string, files map[string]string) (*github.Gist, error) {
 	// 설명에 태그 추가
@@ -156,14 +184,14 @@ func (g *GistClient) CreateGist(description string, files map[string]string) (*g
 	ctx := context.Background()
 	gist := &github.Gist{
 		Description: &description,
-		Public:      github.Bool(false),
+		Public:      github.Bool(config.GetVisibility() == config.VisibilityPublic),
 		Files:       make(map[github.GistFilename]github.GistFile),
 	}
 
 	for filename, content := range files {
 		// 빈 내용은 " "로 대체
 		if content == "" {
-			content = " "
+			content = emptyContent
 		}
 		contentPtr := &content
 		gist.Files[github.GistFilename(filename)] = github.GistFile{
@@ -173,12 +201,49 @@ func (g *GistClient) CreateGist(description string, files map[string]string) (*g
 
 	newGist, _, err := g.client.Gists.Create(ctx, gist)
 	if err != nil {
-		return nil, fmt.Errorf("Gist 생성 실패: %v", err)
+		return nil, apiError("Gist 생성 실패", err)
 	}
 
 	return newGist, nil
 }
 
+// UpdateGist 기존 Gist의 파일을 주어진 내용으로 갱신
+// files에 없는 기존 파일은 삭제되며, Gist의 수정 이력(revision)은 유지된다.
+func (g *GistClient) UpdateGist(gistID string, files map[string]string) (*github.Gist, error) {
+	ctx := context.Background()
+	current, _, err := g.client.Gists.Get(ctx, gistID)
+	if err != nil {
+		return nil, apiError("Gist 조회 실패", err)
+	}
+
+	// 삭제할 파일은 null로 보내야 하므로 github.Gist 대신 직접 요청 본문을 구성
+	payload := make(map[string]interface{})
+	for filename, content := range files {
+		// 빈 내용은 " "로 대체
+		if content == "" {
+			content = emptyContent
+		}
+		payload[filename] = map[string]string{"content": content}
+	}
+	for filename := range current.Files {
+		if _, exists := files[string(filename)]; !exists {
+			payload[string(filename)] = nil
+		}
+	}
+
+	req, err := g.client.NewRequest("PATCH", "gists/"+gistID, map[string]interface{}{"files": payload})
+	if err != nil {
+		return nil, i18n.Errorf("Gist 수정 요청 생성 실패: %w", err)
+	}
+
+	updated := new(github.Gist)
+	if _, err := g.client.Do(ctx, req, updated); err != nil {
+		return nil, apiError("Gist 수정 실패", err)
+	}
+
+	return updated, nil
+}
+
 // FindGistByDescription 프로젝트 이름으로 Gist 찾기
 func (g *GistClient) FindGistByDescription(projectName string) (*github.Gist, error) {
 	gists, err := g.ListGists()
@@ -192,7 +257,7 @@ func (g *GistClient) FindGistByDescription(projectName string) (*github.Gist, er
 		}
 	}
 
-	return nil, fmt.Errorf("프로젝트 '%s'를 찾을 수 없습니다", projectName)
+	return nil, clierr.New(clierr.NotFound, "프로젝트 '%s'를 찾을 수 없습니다", projectName)
 }
 
 // DeleteGist Gist 삭제
@@ -200,7 +265,7 @@ func (g *GistClient) DeleteGist(gistID string) error {
 	ctx := context.Background()
 	_, err := g.client.Gists.Delete(ctx, gistID)
 	if err != nil {
-		return fmt.Errorf("Gist 삭제 실패: %v", err)
+		return apiError("Gist 삭제 실패", err)
 	}
 	return nil
 }
@@ -233,4 +298,4 @@ func (g *GistClient) CheckSyncNeeded(gistID string, localVersion *models.Templat
 	}
 
 	return needsSync, syncFiles, nil
-} 
\ No newline at end of file
+}
@@ -0,0 +1,62 @@
+package gist
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tinysolver/rules-cli/config"
+)
+
+func TestNewGistClientForProfile(t *testing.T) {
+	var gotAuth, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth, gotPath = r.Header.Get("Authorization"), r.URL.Path
+		w.Write([]byte(`{"id":"abc","files":{"a.mdc":{"filename":"a.mdc","content":"rule"}}}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		profile    config.Profile
+		wantUpload string
+	}{
+		{
+			name:       "업로드 주소 기본값",
+			profile:    config.Profile{Name: "ghe", APIURL: server.URL + "/api/v3/"},
+			wantUpload: server.URL + "/api/uploads/",
+		},
+		{
+			name:       "업로드 주소 지정",
+			profile:    config.Profile{Name: "ghe", APIURL: server.URL + "/api/v3/", UploadURL: server.URL + "/custom/api/uploads/"},
+			wantUpload: server.URL + "/custom/api/uploads/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewGistClientForProfile("secret-token", tt.profile)
+			if err != nil {
+				t.Fatalf("NewGistClientForProfile(): %v", err)
+			}
+			if got := client.client.UploadURL.String(); got != tt.wantUpload {
+				t.Errorf("UploadURL = %q, want %q", got, tt.wantUpload)
+			}
+
+			contents, err := client.GetGistContent("abc")
+			if err != nil {
+				t.Fatalf("GetGistContent(): %v", err)
+			}
+			if contents["a.mdc"] != "rule" {
+				t.Errorf("GetGistContent() = %v", contents)
+			}
+			if gotPath != "/api/v3/gists/abc" {
+				t.Errorf("요청 경로 = %q, want /api/v3/gists/abc", gotPath)
+			}
+			if !strings.HasPrefix(gotAuth, "Basic ") {
+				t.Errorf("Authorization = %q, want basic auth with the token", gotAuth)
+			}
+		})
+	}
+}
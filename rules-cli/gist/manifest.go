@@ -0,0 +1,35 @@
+package gist
+
+import (
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+const (
+	// ManifestFile Gist에 함께 저장되는 템플릿 매니페스트 파일 이름
+	ManifestFile = "manifest.json"
+	// SignatureFile 매니페스트 서명 파일 이름
+	SignatureFile = "manifest.sig"
+)
+
+// SplitManifest Gist 내용에서 매니페스트와 서명을 분리
+// 매니페스트가 없는 Gist(이전 버전으로 업로드된 템플릿)는 nil을 반환한다.
+func SplitManifest(contents map[string]string) (*models.TemplateVersion, map[string]string, error) {
+	files := make(map[string]string, len(contents))
+	for filename, content := range contents {
+		if filename != ManifestFile && filename != SignatureFile {
+			files[filename] = content
+		}
+	}
+
+	data, ok := contents[ManifestFile]
+	if !ok {
+		return nil, files, nil
+	}
+
+	manifest, err := models.FromJSONString(data)
+	if err != nil {
+		return nil, nil, i18n.Errorf("매니페스트 파싱 실패: %w", err)
+	}
+	return manifest, files, nil
+}
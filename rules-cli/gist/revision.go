@@ -0,0 +1,184 @@
+package gist
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v58/github"
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+// 파일 변경 상태
+const (
+	FileAdded    = "added"
+	FileModified = "modified"
+	FileDeleted  = "deleted"
+)
+
+// FileChange 수정 이력에서 변경된 파일
+type FileChange struct {
+	Filename string `json:"filename"`
+	Status   string `json:"status"` // added, modified, deleted
+}
+
+// Revision Gist의 수정 이력 한 건
+type Revision struct {
+	Version     string       `json:"version"`      // 수정 이력 SHA
+	CommittedAt time.Time    `json:"committed_at"` // 수정 시간
+	Additions   int          `json:"additions"`    // 추가된 줄 수
+	Deletions   int          `json:"deletions"`    // 삭제된 줄 수
+	Files       []FileChange `json:"files"`        // 변경된 파일 목록
+}
+
+// listCommits Gist의 모든 수정 이력 조회 (최신순)
+func (g *GistClient) listCommits(gistID string) ([]*github.GistCommit, error) {
+	ctx := context.Background()
+	opts := &github.ListOptions{PerPage: 100}
+
+	var commits []*github.GistCommit
+	for {
+		page, resp, err := g.client.Gists.ListCommits(ctx, gistID, opts)
+		if err != nil {
+			return nil, apiError("Gist 수정 이력 조회 실패", err)
+		}
+		commits = append(commits, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return commits, nil
+}
+
+// ListRevisions Gist의 최근 수정 이력과 각 이력에서 변경된 파일 조회 (최신순)
+// limit개(0 이하이면 전체)의 이력만 조회하며, 변경 파일 계산을 위해 바로 이전 이력의 내용을 함께 조회한다.
+func (g *GistClient) ListRevisions(gistID string, limit int) ([]Revision, error) {
+	commits, err := g.listCommits(gistID)
+	if err != nil {
+		return nil, err
+	}
+
+	shown := len(commits)
+	if limit > 0 && limit < shown {
+		shown = limit
+	}
+	fetched := min(shown+1, len(commits))
+	contents, err := g.revisionContents(gistID, commits[:fetched])
+	if err != nil {
+		return nil, err
+	}
+
+	// 바로 이전 이력과 내용을 비교해 변경 파일 계산 (가장 오래된 이력은 모든 파일이 추가됨)
+	revisions := make([]Revision, shown)
+	for i, commit := range commits[:shown] {
+		var previous map[string]string
+		if i+1 < fetched {
+			previous = contents[i+1]
+		}
+
+		revisions[i] = Revision{
+			Version:     commit.GetVersion(),
+			CommittedAt: commit.GetCommittedAt().Time,
+			Additions:   commit.GetChangeStatus().GetAdditions(),
+			Deletions:   commit.GetChangeStatus().GetDeletions(),
+			Files:       diffContents(previous, contents[i]),
+		}
+	}
+
+	return revisions, nil
+}
+
+// revisionContents 여러 수정 이력의 내용을 동시에 조회 (commits와 같은 순서)
+func (g *GistClient) revisionContents(gistID string, commits []*github.GistCommit) ([]map[string]string, error) {
+	contents := make([]map[string]string, len(commits))
+	err := forEachConcurrently(len(commits), func(i int) error {
+		var err error
+		contents[i], err = g.GetRevisionContent(gistID, commits[i].GetVersion())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
+
+// GetRevisionContent 특정 수정 이력 시점의 Gist 내용 조회
+func (g *GistClient) GetRevisionContent(gistID, sha string) (map[string]string, error) {
+	ctx := context.Background()
+	gist, _, err := g.client.Gists.GetRevision(ctx, gistID, sha)
+	if err != nil {
+		return nil, apiError("Gist 수정 이력 내용 조회 실패", err)
+	}
+
+	contents := make(map[string]string)
+	for _, file := range gist.Files {
+		if file.Content != nil {
+			contents[file.GetFilename()] = restoreEmpty(*file.Content)
+		}
+	}
+
+	return contents, nil
+}
+
+// LatestRevision Gist의 가장 최근 수정 이력 SHA 조회
+func (g *GistClient) LatestRevision(gistID string) (string, error) {
+	ctx := context.Background()
+	commits, _, err := g.client.Gists.ListCommits(ctx, gistID, &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return "", apiError("Gist 수정 이력 조회 실패", err)
+	}
+	if len(commits) == 0 {
+		return "", i18n.Errorf("Gist 수정 이력이 없습니다")
+	}
+	return commits[0].GetVersion(), nil
+}
+
+// ResolveRevision 전체 또는 앞부분 SHA로 수정 이력 찾기
+func (g *GistClient) ResolveRevision(gistID, ref string) (string, error) {
+	commits, err := g.listCommits(gistID)
+	if err != nil {
+		return "", err
+	}
+
+	var matches []string
+	for _, commit := range commits {
+		if strings.HasPrefix(commit.GetVersion(), ref) {
+			matches = append(matches, commit.GetVersion())
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", clierr.New(clierr.NotFound, "수정 이력 '%s'를 찾을 수 없습니다", ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", clierr.New(clierr.Validation, "수정 이력 '%s'에 해당하는 이력이 여러 개입니다. 더 긴 SHA를 지정하세요", ref)
+	}
+}
+
+// diffContents 두 시점의 파일 내용을 비교해 변경 파일 목록 생성
+func diffContents(before, after map[string]string) []FileChange {
+	var changes []FileChange
+	for filename, content := range after {
+		old, exists := before[filename]
+		switch {
+		case !exists:
+			changes = append(changes, FileChange{Filename: filename, Status: FileAdded})
+		case old != content:
+			changes = append(changes, FileChange{Filename: filename, Status: FileModified})
+		}
+	}
+	for filename := range before {
+		if _, exists := after[filename]; !exists {
+			changes = append(changes, FileChange{Filename: filename, Status: FileDeleted})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Filename < changes[j].Filename })
+	return changes
+}
@@ -0,0 +1,433 @@
+package i18n
+
+// english 영어 카탈로그 (한국어 원문 → 영어, 원문 가나다순)
+// 원문 끝의 줄바꿈은 T가 그대로 붙이므로 키에서 뺀다.
+var english = map[string]string{
+	"    globs: %s (추천)": "    globs: %s (suggested)",
+	"  변경 없음":            "  no changes",
+	"  변경: %s":           "  modified: %s",
+	"  삭제: %s":           "  deleted: %s",
+	"  추가: %s":           "  added: %s",
+	" [암호화]":             " [encrypted]",
+	"\"ed25519 <base64> <설명>\" 형식의 공개 키 또는 공개 키 파일(.pub)을 신뢰 목록에 추가합니다.": "Adds a public key in the \"ed25519 <base64> <comment>\" format, or a public key file (.pub), to the trusted keys.",
+	"%d개의 규칙이 생성되었습니다.":                   "Created %d rules.",
+	"%s %s (저장소: %s, 네임스페이스: %s, 서버: %s)": "%s %s (backend: %s, namespace: %s, server: %s)",
+	"%s %s: %d개 파일 갱신":                    "%s %s: updated %d files",
+	"%s (누락)":                             "%s (missing)",
+	"%s (매니페스트에 없음)":                      "%s (not in the manifest)",
+	"%s (지원하지 않는 해시 형식)":                  "%s (unsupported hash format)",
+	"%s 계정으로 인증되었습니다. 토큰이 %s에 저장되었습니다.": "Authenticated as %s. The token was saved to %s.",
+	"%s 또는 %s 파일을 찾을 수 없습니다":            "Could not find %s or %s",
+	"%s 읽기 실패: %w":              "failed to read %s: %w",
+	"%s 파싱 실패: %w":              "failed to parse %s: %w",
+	"%s 파일을 %d개의 규칙으로 분리합니다:":   "Splitting %s into %d rules:",
+	"%s 파일을 읽을 수 없습니다: %w":      "cannot read %s: %w",
+	"%s 파일을 찾을 수 없습니다: %w":      "cannot find %s: %w",
+	"%s 형식에서 %d개의 규칙을 가져왔습니다.":  "Imported %[2]d rules from the %[1]s format.",
+	"%s 환경 변수":                  "%s environment variable",
+	"%s 환경 변수로 암호를 지정하세요":       "Set the passphrase with the %s environment variable",
+	"%s: %s에 없어 제거 (%d개 파일 삭제)": "%s: not in %s, removed (%d files deleted)",
+	"%s: 이미 최신입니다. (%s)":        "%s: already up to date. (%s)",
+	"%s: 입력을 받을 수 없습니다. %s":     "%s: cannot read input. %s",
+	"%s: 입력을 받을 수 없어 확인할 수 없습니다. 계속하려면 %s 옵션을 지정하세요": "%s: cannot ask for confirmation without input. Pass %s to continue",
+	"%s: 템플릿 '%s': %w":       "%s: template '%s': %w",
+	"%s: 템플릿 이름이 비어 있습니다":    "%s: template name is empty",
+	"%s과 %s에 기록된 템플릿이 없습니다.": "No templates are recorded in %s or %s.",
+	"%s는 파일 단위 적용 범위를 지원하지 않아 globs는 안내 문구로만 남고, 모든 규칙이 항상 적용됩니다. 규칙 외 파일은 내보내지 않습니다.": "%s does not support per-file scopes, so globs are kept only as a note and every rule always applies. Non-rule files are not exported.",
+	"%s에 '%s' 템플릿이 없습니다.":               "There is no template '%s' in %s.",
+	"%s에 기록된 템플릿이 없습니다.":                "No templates are recorded in %s.",
+	"%s이(가) 저장되었습니다.":                   "Saved %s.",
+	"%w\n수정한 내용은 반영되지 않았으며 %s에 남아 있습니다": "%w\nYour edits were not applied and remain in %s",
+	"%w (키 ID: %s)":                "%w (key ID: %s)",
+	"%w: 지원하지 않는 알고리즘 %s":          "%w: unsupported algorithm %s",
+	"'%s' 조건에 맞는 버전이 없습니다":         "no version matches '%s'",
+	"'%s' 키가 이미 존재합니다: %s":         "key '%s' already exists: %s",
+	"'%s' 파일이 암호화되어 있지 않습니다":       "file '%s' is not encrypted",
+	"'%s'에는 값을 하나만 지정할 수 있습니다":     "'%s' takes exactly one value",
+	"'%s'은(는) 프로젝트 설정에 저장할 수 없습니다": "'%s' cannot be stored in the project settings",
+	"'%s'의 값은 %s 중 하나여야 합니다: %s":   "the value of '%s' must be one of %s: %s",
+	"'%s'의 값은 문자열 목록이어야 합니다":       "the value of '%s' must be a list of strings",
+	"'%s'의 값은 문자열이어야 합니다":          "the value of '%s' must be a string",
+	"(이름 없음)":      "(unnamed)",
+	"--profile 옵션": "--profile flag",
+	".cursorrulesignore 또는 기본 제외 패턴에 의해 제외된 파일 출력": "show files excluded by .cursorrulesignore or the default exclude patterns",
+	".cursorrulesignore에 의해 제외된 파일 출력":             "Show files excluded by .cursorrulesignore",
+	"AWS 비밀 키":       "AWS secret key",
+	"AWS 액세스 키":      "AWS access key",
+	"CLI 설정 조회 및 변경": "View and change CLI settings",
+	"CLI 설정을 조회하고 변경합니다. --project 옵션을 사용하면 전역 설정 대신\n프로젝트 루트의 .cursorrules-cli.json 파일에 저장하며, 프로젝트 설정이 전역 설정보다 우선합니다.": "Views and changes CLI settings. With --project, values are stored in .cursorrules-cli.json\nat the project root instead of the global settings, and project settings take precedence over global ones.",
+	"Cursor Rules CLI는 터미널에서 Cursor rules 파일을 관리하는 도구입니다.": "Cursor Rules CLI is a tool for managing Cursor rules files from the terminal.",
+	"Gist 내용 조회 실패":         "failed to fetch gist contents",
+	"Gist 대신 로컬 규칙을 번들로 저장": "Bundle the local rules instead of a gist",
+	"Gist 목록 조회 실패":         "failed to list gists",
+	"Gist 삭제 실패":            "failed to delete gist",
+	"Gist 생성 실패":            "failed to create gist",
+	"Gist 수정 실패":            "failed to update gist",
+	"Gist 수정 요청 생성 실패: %w":  "failed to build gist update request: %w",
+	"Gist 수정 이력 내용 조회 실패":   "failed to fetch gist revision contents",
+	"Gist 수정 이력 조회 실패":      "failed to fetch gist revisions",
+	"Gist 수정 이력이 없습니다":      "the gist has no revisions",
+	"Gist 정보 조회 실패":         "failed to fetch gist",
+	"Gist 조회 실패":            "failed to fetch gist",
+	"Gist 클라이언트 생성 실패: %w":  "failed to create gist client: %w",
+	"GitHub Enterprise Server API 주소 (예: https://github.example.com/api/v3/)": "GitHub Enterprise Server API URL (e.g. https://github.example.com/api/v3/)",
+	"GitHub Enterprise Server 업로드 주소 (기본값: API 호스트의 /api/uploads/)":           "GitHub Enterprise Server upload URL (default: /api/uploads/ on the API host)",
+	"GitHub Personal Access Token 설정":                                         "Set a GitHub personal access token",
+	"GitHub Personal Access Token을 입력받아 확인한 뒤 저장합니다.\n토큰으로 로그인 계정을 조회하고 gist 권한이 있는지 확인한 후에만 저장합니다.": "Reads a GitHub personal access token, verifies it and saves it.\nThe token is saved only after looking up its account and confirming it has the gist scope.",
+	"GitHub 로그인": "Log in to GitHub",
+	"GitHub 토큰":  "GitHub token",
+	"GitHub 토큰이 설정되지 않았습니다. 'cursorrules auth' 명령어로 토큰을 설정하세요":  "no GitHub token is configured. Set one with 'cursorrules auth'",
+	"GitHub 토큰이 설정되지 않았습니다. 'cursorrules auth' 명령어로 토큰을 설정하세요.": "No GitHub token is configured. Set one with 'cursorrules auth'.",
+	"GitHub에 로그인합니다. --device 옵션을 사용하면 토큰을 붙여넣는 대신\n브라우저에서 인증 코드를 입력하는 OAuth 기기 인증 흐름으로 토큰을 발급받습니다.\n설정 파일의 oauth_client_id에 기기 인증을 허용한 OAuth 앱의 클라이언트 ID가 필요합니다.": "Logs in to GitHub. With --device, instead of pasting a token you get one through the\nOAuth device flow by entering a code in your browser.\nThis requires oauth_client_id in the config file to be the client ID of an OAuth app with device flow enabled.",
+	"JSON 변환 실패: %w": "failed to encode JSON: %w",
+	"JSON 파싱 실패: %w": "failed to parse JSON: %w",
+	"OAuth 기기 인증 흐름으로 로그인 (기본값이 없으므로 oauth_client_id 설정 필요)":    "Log in with the OAuth device flow (requires oauth_client_id, which has no default)",
+	"OAuth 클라이언트 ID가 설정되지 않았습니다":                                "no OAuth client ID is configured",
+	"OAuth 클라이언트 ID가 설정되지 않았습니다. 설정 파일의 oauth_client_id를 지정하세요": "no OAuth client ID is configured. Set oauth_client_id in the config file",
+	"OS 키링": "OS keyring",
+	"OS 키링을 사용할 수 없습니다 (Secret Service가 실행 중인지 확인하세요)": "the OS keyring is unavailable (check that the Secret Service is running)",
+	"PBKDF2 반복 횟수가 올바르지 않습니다: %d":                      "invalid PBKDF2 iteration count: %d",
+	"config edit에서 사용할 편집기 (기본값: $VISUAL, $EDITOR)":    "Editor used by config edit (default: $VISUAL, $EDITOR)",
+	"cursorrules.lock에 기록된 해시와 로컬 파일을 비교해 수정되거나 삭제된 파일을 보여줍니다.\n어떤 템플릿에도 속하지 않는 로컬 규칙 파일은 미추적 파일로 표시합니다. 네트워크를 사용하지 않습니다.\n.cursorrulesignore에 해당하는 파일은 비교하지 않습니다.": "Compares local files with the hashes recorded in cursorrules.lock and shows modified or deleted files.\nLocal rule files that belong to no template are shown as untracked. No network access is used.\nFiles matching .cursorrulesignore are not compared.",
+	"ed25519 개인 키가 아닙니다: %s": "not an ed25519 private key: %s",
+	"ed25519 서명 키를 생성해 ~/.cursorrules/keys에 저장하고, 공개 키를 신뢰 목록에 추가합니다.":                                           "Generates an ed25519 signing key, stores it in ~/.cursorrules/keys and adds its public key to the trusted keys.",
+	"globs는 applyTo로 옮겨지지만, globs 없이 필요할 때만 적용되는 규칙(alwaysApply: false)은 항상 적용되는 지침으로 바뀝니다. 규칙 외 파일은 내보내지 않습니다.": "globs are moved to applyTo, but rules applied only on request without globs (alwaysApply: false) become always-applied instructions. Non-rule files are not exported.",
+	"nonce 생성 실패: %w":                    "failed to generate nonce: %w",
+	"salt 생성 실패: %w":                     "failed to generate salt: %w",
+	"salt 형식이 올바르지 않습니다: %w":             "invalid salt: %w",
+	"token_command 실행 실패 (%s): %w":       "token_command failed (%s): %w",
+	"token_command가 토큰을 출력하지 않았습니다 (%s)": "token_command printed no token (%s)",
+	"가져오기 실패: %w":                        "import failed: %w",
+	"가져올 형식 (%s)":                        "Format to import (%s)",
+	"강제로 덮어쓰기":                           "Overwrite existing files",
+	"개인 키":                               "Private key",
+	"개인 키 저장 실패: %w":                     "failed to save private key: %w",
+	"개인 키 파싱 실패: %w":                     "failed to parse private key: %w",
+	"개인 키 파일 형식이 올바르지 않습니다: %s":          "invalid private key file: %s",
+	"갱신이 취소되었습니다.":                       "The update was canceled.",
+	"결과 변환 실패: %w":                       "failed to encode result: %w",
+	"경고: %s 설정의 '%s' 값을 무시합니다: %v":       "Warning: ignoring the %s setting '%s': %v",
+	"경고: %v": "Warning: %v",
+	"경고: '%s' 파일은 %s 형식으로 내보낼 수 없습니다":                                     "Warning: '%s' cannot be exported to the %s format",
+	"경고: '%s' 파일은 업로드되지 않습니다 (%s)":                                        "Warning: '%s' will not be uploaded (%s)",
+	"경고: fine-grained 토큰은 쓰기 권한을 확인할 수 없습니다. Gists 쓰기 권한이 있는지 확인하세요.":     "Warning: write permission of fine-grained tokens cannot be checked. Make sure the token has Gists write permission.",
+	"경고: 비밀 정보로 의심되는 내용이 포함되어 있습니다:":                                      "Warning: possible secrets found:",
+	"경고: 서명 키가 없어 서명하지 않고 업로드합니다. 'cursorrules keys generate'로 키를 생성하세요.": "Warning: no signing key found, uploading unsigned. Create one with 'cursorrules keys generate'.",
+	"경고: 서명 키가 없어 서명하지 않고 저장합니다. 'cursorrules keys generate'로 키를 생성하세요.":  "Warning: no signing key found, saving without a signature. Create one with 'cursorrules keys generate'.",
+	"경고: 템플릿 '%s' 서명 검증 실패: %v":                                           "Warning: signature verification failed for template '%s': %v",
+	"경고: 토큰에 %s 권한이 없어 템플릿을 저장할 수 없습니다.":                                  "Warning: the token lacks the %s scope and cannot store templates.",
+	"경고: 토큰으로 Gist에 접근할 수 없어 템플릿을 사용할 수 없습니다.":                            "Warning: the token cannot access gists, so templates cannot be used.",
+	"경로가 비어 있습니다":                      "path is empty",
+	"경로에 NUL 문자가 있습니다":                 "path contains a NUL character",
+	"계정별 프로필 관리":                       "Manage per-account profiles",
+	"공개 키 저장 실패: %w":                   "failed to save public key: %w",
+	"공개 키가 신뢰 목록에 추가되었습니다. (키 ID: %s)": "Added the public key to the trusted keys. (key ID: %s)",
+	"공개 키를 신뢰 목록에 추가":                  "Add a public key to the trusted keys",
+	"권한: %s":                           "Scopes: %s",
+	"권한: 확인할 수 없음 (fine-grained 토큰)":   "Scopes: unknown (fine-grained token)",
+	"규칙 디렉토리를 생성할 수 없습니다: %w":          "cannot create the rules directory: %w",
+	"규칙 파일로 취급할 파일 패턴":                 "File patterns treated as rule files",
+	"규칙 파일에서 제외할 파일 패턴":                "File patterns excluded from rule files",
+	"기기 인증 OAuth 서버 주소":                "OAuth server URL for the device flow",
+	"기기 인증 로그인에 사용할 OAuth 앱 클라이언트 ID (기본값 없음, auth login --device에 필요)": "OAuth app client ID used for device flow login (no default; required by auth login --device)",
+	"기본값": "default",
+	"기본으로 사용할 프로필 지정":                               "Set the default profile",
+	"기존 템플릿 복호화 실패: %w":                             "failed to decrypt the existing template: %w",
+	"기존 템플릿이 암호화되어 있어 암호화하여 업로드합니다.":                "The existing template is encrypted, so this upload is encrypted too.",
+	"내보내기 실패: %w":                                   "export failed: %w",
+	"내보낼 규칙 파일이 없습니다":                               "there are no rule files to export",
+	"내보낼 형식 (%s)":                                   "Format to export (%s)",
+	"내용을 확인한 후에도 업로드하려면 --allow-secrets 옵션을 사용하세요.": "To upload anyway after reviewing the contents, use --allow-secrets.",
+	"높은 엔트로피 문자열":                                   "High-entropy string",
+	"다른 AI 어시스턴트 형식의 지침을 로컬 규칙으로 가져오기":              "Import instructions from another AI assistant format as local rules",
+	"다음 파일이 로컬에서 수정되었습니다 (덮어쓰면 .bak으로 백업됩니다):":      "The following files were modified locally (overwritten files are backed up to .bak):",
+	"다음 파일이 이미 존재합니다 (덮어쓰면 .bak으로 백업됩니다):":          "The following files already exist (they are backed up to .bak when overwritten):",
+	"덮어쓰시겠습니까?":                                     "Overwrite?",
+	"데이터 읽기 실패: %w":                                 "failed to read data: %w",
+	"디렉토리 생성 실패: %w":                                "failed to create directory: %w",
+	"디렉토리 읽기 실패: %w":                                "failed to read directory: %w",
+	"레거시 .cursorrules 파일을 .cursor/rules 규칙으로 분리":    "Split a legacy .cursorrules file into .cursor/rules rules",
+	"로그인: %s": "Login: %s",
+	"로컬 규칙 파일과 원격 템플릿의 차이를 unified diff로 보여줍니다.\n이름을 지정하면 해당 템플릿의 최신 수정 이력(또는 @참조)과 비교하고,\n지정하지 않으면 cursorrules.lock의 모든 템플릿을 설치한 수정 이력과 비교합니다.\n.cursorrulesignore에 해당하는 파일은 비교하지 않습니다.": "Shows the differences between local rule files and remote templates as a unified diff.\nWith a name, compares against that template's latest revision (or the @ref);\nwithout one, compares every template in cursorrules.lock against its installed revision.\nFiles matching .cursorrulesignore are not compared.",
+	"로컬 규칙을 다른 AI 어시스턴트 형식으로 내보내기":                                  "Export local rules to another AI assistant format",
+	"로컬 규칙을 다른 AI 어시스턴트의 지침 파일 형식으로 변환해 프로젝트 루트에 저장합니다.\n지원 형식: %s": "Converts local rules to another AI assistant's instruction file format and saves it at the project root.\nSupported formats: %s",
+	"로컬 템플릿 로드 실패: %w":                                  "failed to load local template: %w",
+	"로컬 템플릿 업로드":                                        "Upload the local template",
+	"로컬 파일과 병합":                                         "Merge with local files",
+	"로컬 파일과 원격 템플릿의 차이 출력":                              "Show differences between local files and remote templates",
+	"로컬에서 수정된 파일이 있어 갱신을 중단합니다: %s":                     "Aborting the update because some files were modified locally: %s",
+	"로컬에서 수정된 파일이 있어 설치를 중단합니다: %s":                     "Aborting the install because some files were modified locally: %s",
+	"로컬에서 수정한 파일도 확인 없이 덮어쓰기":                           "Overwrite locally modified files without asking",
+	"로컬에서 수정한 파일은 유지":                                   "Keep locally modified files",
+	"매니페스트 파싱 실패: %w":                                   "failed to parse manifest: %w",
+	"매니페스트를 조회하지 않고 출력 (설명, 배포 버전, 암호화 여부 생략)":          "List without fetching manifests (omits description, release and encryption)",
+	"매니페스트의 해시와 일치하지 않는 파일이 있습니다: %s":                   "files do not match the manifest hashes: %s",
+	"메시지 언어 (auto는 LC_ALL, LC_MESSAGES, LANG을 따름)":      "Message language (auto follows LC_ALL, LC_MESSAGES, LANG)",
+	"명령 결과 출력 형식":                                       "Output format for command results",
+	"모든 설정 값 출력":                                        "Show all settings",
+	"모든 파일이 최신 상태입니다.":                                  "All files are up to date.",
+	"무시 패턴 파일을 읽을 수 없습니다: %w":                           "cannot read the ignore file: %w",
+	"무시됨: %s (%s)":                                      "Ignored: %s (%s)",
+	"무시됨: '%s' (%s)":                                    "Ignored: '%s' (%s)",
+	"미추적 파일:":                                           "Untracked files:",
+	"배포된 버전이 없습니다. 'upload --bump'로 버전을 배포하세요":          "no versions have been released. Release one with 'upload --bump'",
+	"백업 생성 실패: %w":                                      "failed to create backup: %w",
+	"버전 %s이(가) 배포되었습니다. (수정 이력 %s)":                     "Released version %s. (revision %s)",
+	"버전 배포 실패: %w":                                      "failed to release version: %w",
+	"버전 정보 변환 실패: %w":                                   "failed to encode version info: %w",
+	"버전 정보 저장 실패: %w":                                   "failed to save version info: %w",
+	"번들 '%s'의 템플릿이 설치되었습니다. (%d개 파일)":                   "Installed the template from bundle '%s'. (%d files)",
+	"번들 내용이 너무 큽니다 (최대 %d바이트)":                          "bundle contents are too large (max %d bytes)",
+	"번들 읽기 실패: %w":                                      "failed to read bundle: %w",
+	"번들 작성 실패: %w":                                      "failed to write bundle: %w",
+	"번들 저장 실패: %w":                                      "failed to save bundle: %w",
+	"번들 파일 경로 (.tar.gz, .zip, .json, 기본값: <이름>.tar.gz)": "Bundle file path (.tar.gz, .zip, .json; default: <name>.tar.gz)",
+	"번들 파일 생성 실패: %w":                                   "failed to create bundle file: %w",
+	"번들 파일에서 템플릿 설치":                                    "Install a template from a bundle file",
+	"번들 파일의 템플릿을 설치합니다. Gist에서 내려받을 때와 같이 매니페스트의 해시와 서명을 검증하며,\n서명이 없는 번들(.json 번들 포함)은 --allow-unsigned 옵션을 지정해야 설치할 수 있습니다.": "Installs the template in a bundle file. The manifest hashes and signature are verified as for a gist download,\nand unsigned bundles (including .json bundles) can only be installed with --allow-unsigned.",
+	"번들 항목이 너무 큽니다: %s (최대 %d바이트)": "bundle entry is too large: %s (max %d bytes)",
+	"번들에 %s 파일이 없습니다":              "the bundle has no %s file",
+	"번들에 올바르지 않은 경로가 있습니다: %s":     "the bundle contains an invalid path: %s",
+	"번들에 저장할 매니페스트가 없습니다":          "there is no manifest to store in the bundle",
+	"변경 내용만 보여주고 갱신하지 않음":          "Show changes without applying them",
+	"변경 없음": "unchanged",
+	"변환 후 원본 .cursorrules 파일 유지":                                   "Keep the original .cursorrules file after converting",
+	"변환이 취소되었습니다.":                                                 "Conversion canceled.",
+	"변환할 내용이 없습니다.":                                                "Nothing to convert.",
+	"복호화 실패: 암호 또는 키가 올바르지 않거나 내용이 변조되었습니다":                        "decryption failed: the passphrase or key is wrong, or the contents were tampered with",
+	"브라우저에서 %s 에 접속해 다음 코드를 입력하세요: %s":                             "Open %s in your browser and enter this code: %s",
+	"비밀 값 저장소 조회 실패: %w":                                           "failed to open the secret store: %w",
+	"비밀 값 키 생성 실패: %w":                                             "failed to generate the secret key: %w",
+	"비밀 값 키 파일 저장 실패: %w":                                          "failed to save the secret key file: %w",
+	"비밀 값 키 파일을 읽을 수 없습니다: %w":                                     "cannot read the secret key file: %w",
+	"비밀 값 키 파일이 올바르지 않습니다: %s":                                     "the secret key file is invalid: %s",
+	"비밀 값 파일 변환 실패: %w":                                            "failed to encode the secrets file: %w",
+	"비밀 값 파일 저장 실패: %w":                                            "failed to save the secrets file: %w",
+	"비밀 값 파일 파싱 실패: %w":                                            "failed to parse the secrets file: %w",
+	"비밀 값 파일을 읽을 수 없습니다: %w":                                       "cannot read the secrets file: %w",
+	"비밀 정보로 의심되는 내용이 있어 업로드를 중단합니다:":                               "Upload aborted because possible secrets were found:",
+	"비밀 정보로 의심되는 내용이 있어도 업로드":                                      "Upload even if possible secrets are found",
+	"사용자 정보 조회 실패":                                                 "failed to fetch user info",
+	"사용자 정의 패턴 %s":                                                 "custom pattern %s",
+	"사용자가 인증을 거부했습니다":                                              "the user denied the authorization",
+	"사용할 프로필 (기본값: CURSORRULES_PROFILE 또는 'profile use'로 지정한 프로필)": "Profile to use (default: CURSORRULES_PROFILE or the profile set with 'profile use')",
+	"삭제: %s (%s에 없음)":                                              "Deleted: %s (not in %s)",
+	"삭제가 취소되었습니다.":                                                 "Deletion canceled.",
+	"상대 경로 변환 실패: %w":                                              "failed to make relative path: %w",
+	"상위 디렉토리(..)를 참조할 수 없습니다":                                      "parent directory (..) references are not allowed",
+	"새 서명 키 생성":                                                    "Generate a new signing key",
+	"새로 업로드하는 템플릿의 공개 범위 (전역 설정만 가능)":                              "Visibility of newly uploaded templates (global setting only)",
+	"색상 출력 여부":                                                     "Whether to use colored output",
+	"서명 변환 실패: %w":                                                 "failed to encode signature: %w",
+	"서명 키 '%s'이(가) 생성되었습니다. (키 ID: %s)":                            "Generated signing key '%s'. (key ID: %s)",
+	"서명 키 로드 실패: %w":                                               "failed to load signing key: %w",
+	"서명 키 이름은 영문 소문자, 숫자, '-', '_'만 사용할 수 있습니다: %s":                "signing key names may only contain lowercase letters, digits, '-' and '_': %s",
+	"서명 파싱 실패: %w":                                                 "failed to parse signature: %w",
+	"서명이 없거나 올바르지 않은 템플릿도 설치":                                      "Install templates even if they are unsigned or have an invalid signature",
+	"서명이 올바르지 않습니다":                                                "the signature is invalid",
+	"설정 값 변경":                                                      "Change a setting",
+	"설정 값 삭제 (기본값으로 되돌림)":                                          "Remove a setting (revert to the default)",
+	"설정 값 출력":                                                      "Show a setting",
+	"설정 값을 변경합니다. 목록 값은 쉼표로 구분하거나 여러 인자로 지정합니다.": "Changes a setting. List values are separated by commas or given as multiple arguments.",
+	"설정 디렉토리 권한 변경 실패: %w":                       "failed to change config directory permissions: %w",
+	"설정 디렉토리 생성 실패: %w":                          "failed to create config directory: %w",
+	"설정 디렉토리를 생성할 수 없습니다: %w":                    "cannot create the config directory: %w",
+	"설정 변환 실패: %w":                               "failed to encode settings: %w",
+	"설정 초기화 실패: %w":                              "failed to initialize settings: %w",
+	"설정 파일":                                      "config file",
+	"설정 파일 (평문)":                                 "config file (plaintext)",
+	"설정 파일 권한 변경 실패: %w":                         "failed to change config file permissions: %w",
+	"설정 파일 저장 실패: %w":                            "failed to save config file: %w",
+	"설정 파일을 읽을 수 없습니다: %w":                       "cannot read the config file: %w",
+	"설정 파일을 편집기로 엽니다. 저장한 내용에 알 수 없는 키나 잘못된 값이 있으면 반영하지 않습니다.": "Opens the config file in an editor. The saved contents are not applied if they contain unknown keys or invalid values.",
+	"설정 파일의 token_command":                         "config file token_command",
+	"설정 파일이 올바른 JSON이 아닙니다: %w":                    "the config file is not valid JSON: %w",
+	"설치 시 로컬 파일과 충돌할 때의 처리 방식":                     "How to handle conflicts with local files during install",
+	"설치가 취소되었습니다.":                                 "Installation canceled.",
+	"설치한 템플릿과 로컬 파일의 상태 출력":                        "Show the status of installed templates and local files",
+	"수정 이력 '%s'를 찾을 수 없습니다":                        "revision '%s' not found",
+	"수정 이력 '%s'에 해당하는 이력이 여러 개입니다. 더 긴 SHA를 지정하세요": "revision '%s' is ambiguous. Use a longer SHA",
+	"수정 이력 조회 실패: %w":                              "failed to fetch revisions: %w",
+	"수정됨":                                          "modified",
+	"신뢰 키 목록 파싱 실패 (%s): %w":                       "failed to parse trusted keys (%s): %w",
+	"신뢰 키 목록을 열 수 없습니다: %w":                        "cannot open the trusted keys: %w",
+	"신뢰 키 목록을 읽을 수 없습니다: %w":                       "cannot read the trusted keys: %w",
+	"신뢰 키 저장 실패: %w":                               "failed to save trusted key: %w",
+	"신뢰하는 공개 키 목록 출력":                              "List trusted public keys",
+	"신뢰하는 공개 키가 없습니다.":                             "There are no trusted public keys.",
+	"신뢰하지 않는 키로 서명되었습니다":                           "signed with an untrusted key",
+	"심볼릭 링크":                                       "symbolic link",
+	"심볼릭 링크가 저장 위치 밖을 가리킵니다: %s":                   "symbolic link points outside the target directory: %s",
+	"심볼릭 링크를 확인할 수 없습니다: %w":                       "cannot resolve symbolic link: %w",
+	"알 수 없는 설정 키입니다: %s (사용 가능: %s)":               "unknown setting key: %s (available: %s)",
+	"암호 또는 키가 비어 있습니다":                             "passphrase or key is empty",
+	"암호가 비어 있습니다":                                  "passphrase is empty",
+	"암호화 업로드에 사용할 키 파일":                            "Key file used for encrypted uploads",
+	"암호화 키 생성 실패: %w":                              "failed to generate encryption key: %w",
+	"암호화 파일":                                       "encrypted file",
+	"암호화 파일 (%s)":                                  "encrypted file (%s)",
+	"업데이트 필요: %s":                                  "Update needed: %s",
+	"업로드 시 사용할 서명 키 이름":                            "Name of the signing key used for uploads",
+	"업로드 전 추가로 검사할 비밀 정보 정규식":                      "Extra secret patterns (regular expressions) checked before upload",
+	"업로드한 내용을 새 버전으로 배포 (major, minor, patch)":     "Release the upload as a new version (major, minor, patch)",
+	"없음":               "none",
+	"예약된 파일 이름입니다: %s": "reserved file name: %s",
+	"올바르지 않은 비밀 정보 패턴입니다 (%s): %w":                 "invalid secret pattern (%s): %w",
+	"올바른 URL이 아닙니다: %s":                            "invalid URL: %s",
+	"올바른 ed25519 공개 키가 아닙니다":                       "not a valid ed25519 public key",
+	"올바른 공개 키 형식이 아닙니다 (예: ed25519 <base64> <설명>)": "invalid public key format (e.g. ed25519 <base64> <comment>)",
+	"올바른 버전 단계가 아닙니다: %s (major, minor, patch)":    "invalid version bump: %s (major, minor, patch)",
+	"올바른 버전 조건이 아닙니다: %s":                          "invalid version constraint: %s",
+	"올바른 버전 형식이 아닙니다: %s (예: v1.2.3)":              "invalid version format: %s (e.g. v1.2.3)",
+	"올바른 버전이 아닙니다: %s":                             "invalid version: %s",
+	"원본 백업 실패: %w":                                 "failed to back up the original: %w",
+	"원본 파일은 %s.bak으로 옮겼습니다.":                       "Moved the original file to %s.bak.",
+	"위 내용으로 규칙을 생성하시겠습니까?":                         "Create rules as shown above?",
+	"유지: %s": "Kept: %s",
+	"유지: %s (내용이 잠금 파일과 다름)":       "Kept: %s (differs from the lock file)",
+	"유지: %s (로컬에서 수정됨)":            "Kept: %s (modified locally)",
+	"이 프로필의 토큰을 출력하는 외부 명령":        "External command that prints this profile's token",
+	"이름\t버전\t파일\t공개\t수정 시간\tID":    "NAME\tVERSION\tFILES\tVISIBILITY\tUPDATED\tID",
+	"이미 존재하는 파일이 있어 설치를 중단합니다: %s": "installation aborted because files already exist: %s",
+	"이제 '%s' 프로필을 사용합니다.":          "Now using profile '%s'.",
+	"인증 시간이 만료되었습니다. 다시 시도하세요":     "authorization timed out. Please try again",
+	"인증 코드 요청 실패: %w":              "failed to request device code: %w",
+	"인증 코드 요청 실패: 응답에 코드가 없습니다":    "failed to request device code: the response has no code",
+	"인증 코드가 만료되었습니다. 다시 시도하세요":     "the device code expired. Please try again",
+	"인증을 기다리는 중...":                "Waiting for authorization...",
+	"인증이 취소되었습니다":                  "authentication was canceled",
+	"임시 파일 생성 실패: %w":              "failed to create temporary file: %w",
+	"임시 파일 저장 실패: %w":              "failed to write temporary file: %w",
+	"입력 실패: %w":                    "failed to read input: %w",
+	"입력을 기다리지 않음 (입력이 필요하면 오류)":    "Never wait for input (fail if input is required)",
+	"입력을 받을 수 없어 편집기를 실행할 수 없습니다. 'cursorrules config set'으로 설정을 변경하세요": "Cannot run the editor because input is not available. Use 'cursorrules config set' to change settings",
+	"입력한 암호가 일치하지 않습니다":      "passphrases do not match",
+	"작업 디렉토리를 찾을 수 없습니다: %w": "cannot determine the working directory: %w",
+	"잠금 파일 갱신 실패: %w":        "failed to update the lock file: %w",
+	"잠금 파일 변환 실패: %w":        "failed to encode the lock file: %w",
+	"잠금 파일 읽기 실패: %w":        "failed to read the lock file: %w",
+	"잠금 파일 저장 실패: %w":        "failed to save the lock file: %w",
+	"잠금 파일 파싱 실패: %w":        "failed to parse the lock file: %w",
+	"잠금 파일에 기록된 템플릿(또는 지정한 템플릿)을 최신 수정 이력으로 갱신하고 변경 내용을 보여줍니다.\n.cursorrules.yaml에 선언된 템플릿은 선언한 버전 조건 안에서 갱신하고 변수와 ignore를 적용해 씁니다.\n새 수정 이력에서 빠진 파일은 삭제하며, 로컬에서 수정한 파일은 충돌 처리 방식(--force, --merge, conflict_policy)에 따라 처리합니다.": "Updates the templates recorded in the lock file (or the given templates) to their latest revisions and shows the changes.\nTemplates declared in .cursorrules.yaml are updated within the declared version constraint and written with their variables and ignore patterns applied.\nFiles dropped from the new revision are deleted, and locally modified files are handled by the conflict policy (--force, --merge, conflict_policy).",
+	"잠금 파일의 템플릿을 최신 수정 이력으로 갱신":       "Update locked templates to their latest revisions",
+	"잠금 파일의 해시와 일치하지 않는 파일이 있습니다: %s": "files do not match the lock file hashes: %s",
+	"저장된 값이 없습니다":                     "no value is stored",
+	"저장된 템플릿 목록을 템플릿마다 매니페스트를 조회해 설명, 최신 배포 버전, 암호화 여부와 함께 출력합니다.\n--fast 옵션을 사용하면 매니페스트를 조회하지 않고 Gist 목록만으로 빠르게 출력합니다.": "Lists saved templates, fetching each template's manifest to show its description, latest release and whether it is encrypted.\nWith --fast, skips the manifests and lists only what the gist listing provides.",
+	"저장된 템플릿이 없습니다.":                                "No templates are stored.",
+	"절대 경로는 사용할 수 없습니다":                             "absolute paths are not allowed",
+	"정말로 '%s' 템플릿을 삭제하시겠습니까?":                       "Really delete template '%s'?",
+	"제외 패턴에 해당":                                     "matches an exclude pattern",
+	"지원하지 않는 번들 형식입니다: %s (.tar.gz, .zip, .json)":   "unsupported bundle format: %s (.tar.gz, .zip, .json)",
+	"지원하지 않는 비밀 값 저장소입니다: %s (auto, keyring, file)": "unsupported secret store: %s (auto, keyring, file)",
+	"지원하지 않는 암호화 방식입니다: %s":                         "unsupported encryption scheme: %s",
+	"지원하지 않는 잠금 파일 형식입니다 (버전 %d). CLI를 업데이트하세요":     "unsupported lock file format (version %d). Please update the CLI",
+	"지원하지 않는 저장소입니다: %s":                            "unsupported backend: %s",
+	"지원하지 않는 저장소입니다: %s (%s)":                       "unsupported backend: %s (%s)",
+	"지원하지 않는 출력 형식입니다: %s (table, json, yaml)":      "unsupported output format: %s (table, json, yaml)",
+	"지원하지 않는 키 유도 방식입니다: %s":                        "unsupported key derivation: %s",
+	"지원하지 않는 파일 형식":                                 "unsupported file type",
+	"지원하지 않는 형식입니다: %s (지원 형식: %s)":                 "unsupported format: %s (supported: %s)",
+	"지침 파일 검색 실패: %w":                               "failed to find instruction files: %w",
+	"참고: %s":                                        "Note: %s",
+	"출력 형식 (table, json, yaml; 기본값: output 설정)":     "Output format (table, json, yaml; default: the output setting)",
+	"출력할 최근 수정 이력 수 (0이면 전체)":                       "Number of recent revisions to show (0 for all)",
+	"키 디렉토리 생성 실패: %w":                              "failed to create key directory: %w",
+	"키 변환 실패: %w":                                   "failed to encode key: %w",
+	"키 생성 실패: %w":                                   "failed to generate key: %w",
+	"키 유도 실패: %w":                                   "key derivation failed: %w",
+	"키 파일로 암호화된 템플릿입니다. 설정 파일의 encryption_key_file에 키 파일 경로를 지정하세요": "the template is encrypted with a key file. Set encryption_key_file in the config file to its path",
+	"키 파일을 읽을 수 없습니다: %w":                                      "cannot read the key file: %w",
+	"템플릿 '%s' 검증 실패: %w":                                       "verification failed for template '%s': %w",
+	"템플릿 '%s' 검증 실패: 매니페스트가 다른 템플릿('%s')의 것입니다":                "failed to verify template '%s': the manifest belongs to another template ('%s')",
+	"템플릿 '%s' 복호화 실패: %w":                                      "failed to decrypt template '%s': %w",
+	"템플릿 '%s' 서명 검증 실패: %w (--allow-unsigned 옵션으로 무시할 수 있습니다)": "signature verification failed for template '%s': %w (use --allow-unsigned to ignore)",
+	"템플릿 '%s' 설치 실패: %w":                                       "failed to install template '%s': %w",
+	"템플릿 '%s' 저장 실패: %w":                                       "failed to save template '%s': %w",
+	"템플릿 '%s' 정리 실패: %w":                                       "failed to clean up template '%s': %w",
+	"템플릿 '%s' 조회 실패: %w":                                       "failed to fetch template '%s': %w",
+	"템플릿 '%s'이(가) %s에 저장되었습니다. (%d개 파일)":                       "Saved template '%s' to %s. (%d files)",
+	"템플릿 '%s'이(가) 성공적으로 다운로드되었습니다.":                            "Downloaded template '%s'.",
+	"템플릿 '%s'이(가) 성공적으로 업로드되었습니다.":                             "Uploaded template '%s'.",
+	"템플릿 내용 조회 실패: %w":                                         "failed to fetch template contents: %w",
+	"템플릿 다운로드":                                                 "Download a template",
+	"템플릿 다운로드 실패: %w":                                          "failed to download template: %w",
+	"템플릿 로드 실패: %w":                                            "failed to load template: %w",
+	"템플릿 목록 출력":                                                "List templates",
+	"템플릿 삭제":                                                   "Delete a template",
+	"템플릿 삭제 실패: %w":                                            "failed to delete template: %w",
+	"템플릿 서명 키 관리":                                              "Manage template signing keys",
+	"템플릿 수정 이력 출력":                                             "Show a template's revision history",
+	"템플릿 암호":                                                   "Template passphrase",
+	"템플릿 암호 확인":                                                "Confirm template passphrase",
+	"템플릿 암호화 실패: %w":                                           "failed to encrypt template: %w",
+	"템플릿 업로드 실패: %w":                                           "failed to upload template: %w",
+	"템플릿 이름 앞에 붙일 기본 네임스페이스":                                   "Default namespace prefixed to template names",
+	"템플릿 저장 실패: %w":                                            "failed to save template: %w",
+	"템플릿 저장소":                                                  "Template backend",
+	"템플릿 조회 실패: %w":                                            "failed to fetch template: %w",
+	"템플릿에 서명이 없습니다":                                            "the template is not signed",
+	"템플릿을 .tar.gz, .zip 또는 .json 번들 파일로 저장합니다. --local 옵션을 사용하면 Gist 대신 로컬 규칙을 저장합니다.\n압축 번들에는 Gist의 매니페스트와 서명이 그대로 포함되며(로컬 규칙은 서명 키로 새로 서명), .json 번들에는 서명이 포함되지 않습니다.": "Saves a template as a .tar.gz, .zip or .json bundle file. With --local, the local rules are saved instead of a gist.\nArchive bundles keep the gist's manifest and signature as is (local rules are signed with your signing key); .json bundles carry no signature.",
+	"템플릿을 다운로드합니다. 이름 뒤에 @수정이력(SHA)을 붙이면 해당 시점의 템플릿을,\n@버전 조건(예: @^1.2, @~1.2.3, @v1.0.0)을 붙이면 조건에 맞는 가장 높은 배포 버전을 설치합니다.":                                               "Downloads a template. Append @revision (SHA) to the name to install the template at that point,\nor a @version constraint (e.g. @^1.2, @~1.2.3, @v1.0.0) to install the highest matching released version.",
+	"템플릿을 오프라인 번들 파일로 저장":    "Save a template as an offline bundle file",
+	"토큰 삭제 실패: %w":           "failed to delete token: %w",
+	"토큰 요청 실패: %s %s":        "token request failed: %s %s",
+	"토큰 요청 실패: %w":           "token request failed: %w",
+	"토큰 요청 실패: 응답에 토큰이 없습니다": "token request failed: the response has no token",
+	"토큰 이전 실패: %w":           "failed to migrate token: %w",
+	"토큰 저장 방식":               "Token storage method",
+	"토큰 저장 실패: %w":           "failed to save token: %w",
+	"토큰 조회 실패: %w":           "failed to look up token: %w",
+	"토큰 출처: %s":              "Token source: %s",
+	"토큰 확인 실패: %w":           "failed to verify token: %w",
+	"토큰: %s":                 "Token: %s",
+	"토큰에 %s 권한이 없습니다 (현재 권한: %s). %s 권한을 포함한 토큰을 발급하세요":             "the token lacks the %s scope (current scopes: %s). Issue a token that includes the %s scope",
+	"토큰으로 Gist에 접근할 수 없습니다. fine-grained 토큰에 Gists 읽기/쓰기 권한을 부여하세요": "the token cannot access gists. Grant Gists read/write permission to the fine-grained token",
+	"토큰은 다음 순서로 찾습니다:\n  1. 프로필의 token_command 실행 결과\n  2. 'cursorrules auth'로 저장한 프로필의 토큰 (OS 키링 또는 암호화 파일)\n  3. CURSORRULES_TOKEN 환경 변수\n  4. GITHUB_TOKEN 환경 변수\n  5. 설정 파일의 token_command 실행 결과\n3~5는 github.com을 사용하는 프로필에만 적용합니다.": "Tokens are looked up in this order:\n  1. output of the profile's token_command\n  2. the profile's token saved with 'cursorrules auth' (OS keyring or encrypted file)\n  3. CURSORRULES_TOKEN environment variable\n  4. GITHUB_TOKEN environment variable\n  5. output of token_command in the config file\nSteps 3-5 only apply to profiles that use github.com.",
+	"토큰을 출력하는 외부 명령": "External command that prints the token",
+	"토큰을 파이프로 전달하거나(echo $TOKEN | cursorrules auth) CURSORRULES_TOKEN 환경 변수를 사용하세요": "Pipe the token (echo $TOKEN | cursorrules auth) or use the CURSORRULES_TOKEN environment variable",
+	"토큰이 올바르지 않거나 만료되었습니다":                                                          "the token is invalid or expired",
+	"토큰이 입력되지 않았습니다. %s":                                                            "no token was entered. %s",
+	"팀원에게 아래 공개 키를 공유해 'cursorrules keys trust'로 등록하도록 하세요:":                        "Share the public key below with your team so they can register it with 'cursorrules keys trust':",
+	"파일 내용을 암호화하여 업로드":                                                              "Encrypt file contents before uploading",
+	"파일 삭제 실패: %w":                                                                  "failed to delete file: %w",
+	"파일 이름이 없습니다":                                                                   "file name is missing",
+	"파일 읽기 실패: %w":                                                                  "failed to read file: %w",
+	"파일 저장 실패: %w":                                                                  "failed to write file: %w",
+	"편집기 실행 실패 (%s): %w":                                                            "failed to run editor (%s): %w",
+	"편집기로 설정 파일 수정":                                                                 "Edit the config file in an editor",
+	"프로젝트 '%s'를 찾을 수 없습니다":                                                          "project '%s' not found",
+	"프로젝트 '%s'의 템플릿이 삭제되었습니다.":                                                      "Deleted the template for project '%s'.",
+	"프로젝트 루트의 .cursorrules.yaml에 선언된 템플릿을 설치해 .cursor/rules를 선언과 일치시킵니다.\n잠금 파일의 수정 이력이 선언한 버전을 만족하면 그대로 설치하고, 아니면 버전을 다시 찾아 잠금 파일을 갱신합니다.\n선언에서 빠진 템플릿은 잠금 파일에서 제거하고, 로컬에서 수정하지 않은 파일은 삭제합니다.\n.cursorrules.yaml이 없으면 cursorrules.lock에 기록된 수정 이력 그대로 설치하고,\n이전에 설치했지만 잠금 파일에 없는 파일은 로컬에서 수정하지 않았으면 삭제합니다.\n파일 해시가 잠금 파일과 다르면 설치하지 않습니다.\n마지막으로 설치한 뒤 로컬에서 수정한 파일은 충돌 처리 방식(--force, --merge, conflict_policy)에 따라 처리합니다.": "Installs the templates declared in .cursorrules.yaml at the project root so that .cursor/rules matches the declaration.\nIf the locked revision satisfies the declared version it is installed as is; otherwise the version is resolved again and the lock file is updated.\nTemplates removed from the declaration are removed from the lock file, and their files are deleted unless modified locally.\nWithout .cursorrules.yaml, the revisions recorded in cursorrules.lock are installed as is,\nand previously installed files that are no longer in the lock file are deleted unless modified locally.\nNothing is installed if file hashes differ from the lock file.\nFiles modified locally since the last install are handled by the conflict policy (--force, --merge, conflict_policy).",
+	"프로젝트 루트의 다른 AI 어시스턴트 지침 파일을 .cursor/rules 규칙으로 변환합니다.\n지원 형식: %s": "Converts another AI assistant's instruction file at the project root into .cursor/rules rules.\nSupported formats: %s",
+	"프로젝트 설정 변환 실패: %w":                                                 "failed to encode project settings: %w",
+	"프로젝트 설정 저장 실패: %w":                                                 "failed to save project settings: %w",
+	"프로젝트 설정 파일 수정":                                                     "Edit the project settings file",
+	"프로젝트 설정 파일 파싱 실패 (%s): %w":                                         "failed to parse project settings (%s): %w",
+	"프로젝트 설정 파일에 저장":                                                    "Store in the project settings file",
+	"프로젝트 설정 파일에서 삭제":                                                   "Remove from the project settings file",
+	"프로젝트 설정 파일을 읽을 수 없습니다: %w":                                         "cannot read the project settings file: %w",
+	"프로젝트에 선언된 템플릿 설치":                                                  "Install the templates declared for the project",
+	"프로필 '%s'의 GitHub Enterprise 주소가 올바르지 않습니다: %w":                     "invalid GitHub Enterprise URL for profile '%s': %w",
+	"프로필 '%s'의 저장소(%s)는 Gist 클라이언트로 사용할 수 없습니다":                         "the backend of profile '%s' (%s) cannot be used with the gist client",
+	"프로필 '%s'이(가) 삭제되었습니다.":                                             "Removed profile '%s'.",
+	"프로필 '%s'이(가) 없습니다":                                                 "profile '%s' does not exist",
+	"프로필 '%s'이(가) 없습니다. 'cursorrules profile add %s'로 추가하세요":            "profile '%s' does not exist. Add it with 'cursorrules profile add %s'",
+	"프로필 '%s'이(가) 없습니다. 'cursorrules profile list'로 확인하세요":              "profile '%s' does not exist. Check 'cursorrules profile list'",
+	"프로필 '%s'이(가) 추가되었습니다. 'cursorrules auth --profile %s'로 토큰을 설정하세요.": "Added profile '%s'. Set its token with 'cursorrules auth --profile %s'.",
+	"프로필 목록 출력":                                                         "List profiles",
+	"프로필 이름은 영문 소문자, 숫자, '-', '_'만 사용할 수 있습니다: %s":                      "profile names may only contain lowercase letters, digits, '-' and '_': %s",
+	"프로필 추가":         "Add a profile",
+	"프로필: %s (%s)":   "Profile: %s (%s)",
+	"프로필과 저장된 토큰 삭제": "Remove a profile and its saved token",
+	"프로필마다 토큰, 저장소, 기본 네임스페이스를 따로 저장합니다.\n사용할 프로필은 --profile 옵션 → CURSORRULES_PROFILE 환경 변수 → 'profile use'로 지정한 프로필 → default 순서로 정해집니다.": "Each profile stores its own token, backend and default namespace.\nThe profile in use is chosen in this order: --profile flag → CURSORRULES_PROFILE environment variable → profile set with 'profile use' → default.",
+	"프로필에 저장소를 지정하지 않았을 때 사용할 저장소":                               "Backend used when a profile does not specify one",
+	"프로필을 추가합니다. 토큰은 'cursorrules auth --profile <이름>'으로 설정하세요.": "Adds a profile. Set its token with 'cursorrules auth --profile <name>'.",
+	"프로필의 token_command": "profile token_command",
+	"허용되지 않는 파일 경로가 있어 저장하지 않았습니다:\n%s": "nothing was saved because the template contains disallowed file paths:\n%s",
+	"현재 사용 중인 토큰의 계정, 권한, 출처 확인":        "Show the account, scopes and source of the current token",
+	"홈 디렉토리를 찾을 수 없습니다: %w":             "cannot find the home directory: %w",
+	"확인 없이 강제 삭제":                       "Delete without confirmation",
+	"확인 없이 변환":                          "Convert without confirmation",
+	"확인 질문에 묻지 않고 yes로 답함":              "Answer yes to confirmation questions without asking",
+	"환경 변수 %s":                          "%s environment variable",
+}
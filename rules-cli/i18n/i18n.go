@@ -0,0 +1,150 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// 메시지는 코드에 한국어 원문으로 작성하고, 다른 언어는 원문을 키로 하는 카탈로그로 번역한다.
+// 카탈로그에 없는 메시지는 원문 그대로 출력한다.
+const (
+	// Korean 원문 언어
+	Korean = "ko"
+	// English 영어
+	English = "en"
+)
+
+// localeEnvs 언어를 정하는 환경 변수 (우선순위 순)
+var localeEnvs = []string{"LC_ALL", "LC_MESSAGES", "LANG"}
+
+var (
+	catalogs = map[string]map[string]string{English: english}
+	current  = Korean
+)
+
+// Register 언어 카탈로그 추가 (원문 → 번역)
+// 이미 있는 언어에 등록하면 같은 원문의 번역을 덮어쓴다.
+func Register(lang string, messages map[string]string) {
+	catalog, ok := catalogs[lang]
+	if !ok {
+		catalog = make(map[string]string, len(messages))
+		catalogs[lang] = catalog
+	}
+	for source, translated := range messages {
+		catalog[source] = translated
+	}
+}
+
+// LoadDir 디렉토리의 <언어>.json 카탈로그 파일을 모두 등록 (디렉토리가 없으면 무시)
+func LoadDir(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return Errorf("메시지 카탈로그를 읽을 수 없습니다: %w", err)
+		}
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return Errorf("메시지 카탈로그 파싱 실패 (%s): %w", path, err)
+		}
+		Register(strings.TrimSuffix(filepath.Base(path), ".json"), messages)
+	}
+	return nil
+}
+
+// Detect 사용할 언어 결정
+// configured가 비어 있거나 auto이면 LC_ALL, LC_MESSAGES, LANG 순서로 로캘을 확인하고,
+// 로캘이 없거나 C/POSIX이면 원문 언어를 사용한다.
+func Detect(configured string) string {
+	if configured != "" && configured != "auto" {
+		return configured
+	}
+	for _, env := range localeEnvs {
+		if lang := parseLocale(os.Getenv(env)); lang != "" {
+			return lang
+		}
+	}
+	return Korean
+}
+
+// parseLocale "en_US.UTF-8" 형식의 로캘에서 언어 코드 추출
+func parseLocale(locale string) string {
+	lang, _, _ := strings.Cut(locale, ".")
+	lang, _, _ = strings.Cut(lang, "@")
+	lang, _, _ = strings.Cut(lang, "_")
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if lang == "" || lang == "c" || lang == "posix" {
+		return ""
+	}
+	return lang
+}
+
+// SetLanguage 메시지 언어 지정
+// 카탈로그가 없는 언어는 영어로 출력한다.
+func SetLanguage(lang string) {
+	lang = strings.ToLower(lang)
+	if _, ok := catalogs[lang]; !ok && lang != Korean {
+		lang = English
+	}
+	current = lang
+}
+
+// Language 현재 메시지 언어
+func Language() string {
+	return current
+}
+
+// T 원문 메시지를 현재 언어로 번역 (번역이 없으면 원문)
+// 끝의 줄바꿈은 그대로 두고 나머지로 카탈로그를 찾는다.
+func T(message string) string {
+	catalog, ok := catalogs[current]
+	if !ok {
+		return message
+	}
+	body := strings.TrimRight(message, "\n")
+	if translated, ok := catalog[body]; ok {
+		return translated + message[len(body):]
+	}
+	return message
+}
+
+// Sprintf 번역한 형식으로 문자열 생성
+func Sprintf(format string, args ...interface{}) string {
+	return fmt.Sprintf(T(format), args...)
+}
+
+// Printf 번역한 형식으로 stdout에 출력
+func Printf(format string, args ...interface{}) {
+	fmt.Printf(T(format), args...)
+}
+
+// Fprintf 번역한 형식으로 w에 출력
+func Fprintf(w io.Writer, format string, args ...interface{}) {
+	fmt.Fprintf(w, T(format), args...)
+}
+
+// Errorf 번역한 형식으로 오류 생성 (%w로 감싼 오류는 errors.Is/As로 확인할 수 있다)
+func Errorf(format string, args ...interface{}) error {
+	return fmt.Errorf(T(format), args...)
+}
+
+// NewError 출력할 때 번역하는 오류 생성
+// 언어를 정하기 전에 만드는 패키지 수준 오류(errors.Is 비교용)에 사용한다.
+func NewError(message string) error {
+	return &messageError{message: message}
+}
+
+type messageError struct {
+	message string
+}
+
+func (e *messageError) Error() string {
+	return T(e.message)
+}
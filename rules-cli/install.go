@@ -0,0 +1,658 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/diff"
+	"github.com/tinysolver/rules-cli/filesystem"
+	"github.com/tinysolver/rules-cli/gist"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/lock"
+	"github.com/tinysolver/rules-cli/models"
+	"github.com/tinysolver/rules-cli/output"
+	"github.com/tinysolver/rules-cli/project"
+	"github.com/tinysolver/rules-cli/terminal"
+)
+
+// installedTemplate install 명령에서 템플릿 하나의 설치 결과
+type installedTemplate struct {
+	Name     string   `json:"name"`
+	Revision string   `json:"revision"`
+	Version  string   `json:"version,omitempty"`
+	Updated  []string `json:"updated"`           // 로컬과 내용이 달라 새로 쓴 파일
+	Kept     []string `json:"kept,omitempty"`    // 로컬에서 수정되어 덮어쓰지 않은 파일
+	Ignored  []string `json:"ignored,omitempty"` // .cursorrules.yaml의 ignore로 제외한 파일
+}
+
+// prunedTemplate 선언에서 빠져 잠금 파일에서 제거한 템플릿
+type prunedTemplate struct {
+	Name    string   `json:"name"`
+	Removed []string `json:"removed"` // 삭제한 파일
+	Kept    []string `json:"kept"`    // 로컬에서 수정되어 남겨 둔 파일
+}
+
+// installSummary install 명령 결과
+type installSummary struct {
+	Templates []installedTemplate `json:"templates"`
+	Pruned    []prunedTemplate    `json:"pruned,omitempty"`
+	Removed   []string            `json:"removed,omitempty"` // 더 이상 잠금 파일에 없어 삭제한 파일
+	Kept      []string            `json:"kept,omitempty"`    // 잠금 파일에 없지만 로컬에서 수정되어 남겨 둔 파일
+}
+
+var installCmd = &cobra.Command{
+	Use:   "install",
+	Short: "프로젝트에 선언된 템플릿 설치",
+	Long: "프로젝트 루트의 .cursorrules.yaml에 선언된 템플릿을 설치해 .cursor/rules를 선언과 일치시킵니다.\n" +
+		"잠금 파일의 수정 이력이 선언한 버전을 만족하면 그대로 설치하고, 아니면 버전을 다시 찾아 잠금 파일을 갱신합니다.\n" +
+		"선언에서 빠진 템플릿은 잠금 파일에서 제거하고, 로컬에서 수정하지 않은 파일은 삭제합니다.\n" +
+		".cursorrules.yaml이 없으면 cursorrules.lock에 기록된 수정 이력 그대로 설치하고,\n" +
+		"이전에 설치했지만 잠금 파일에 없는 파일은 로컬에서 수정하지 않았으면 삭제합니다.\n" +
+		"파일 해시가 잠금 파일과 다르면 설치하지 않습니다.\n" +
+		"마지막으로 설치한 뒤 로컬에서 수정한 파일은 충돌 처리 방식(--force, --merge, conflict_policy)에 따라 처리합니다.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectDir, err := filesystem.GetProjectDir()
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		lockfile, err := lock.Load(projectDir)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+		declared, err := project.Load(projectDir)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+		if declared == nil && len(lockfile.Templates) == 0 {
+			return fail(clierr.NotFound, i18n.Errorf("%s과 %s에 기록된 템플릿이 없습니다.", project.FileName, lock.FileName))
+		}
+
+		// 로컬 수정 여부는 이 컴퓨터에 마지막으로 설치한 해시와 비교해 판단
+		installed, err := lock.LoadInstalled(projectDir)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+
+		client, err := gist.NewGistClient()
+		if err != nil {
+			return fail(clierr.Auth, i18n.Errorf("Gist 클라이언트 생성 실패: %w", err))
+		}
+
+		summary := installSummary{Templates: []installedTemplate{}}
+		if declared != nil {
+			if err := installDeclared(cmd, client, projectDir, declared, lockfile, installed, &summary); err != nil {
+				return fail(clierr.Internal, err)
+			}
+		} else {
+			for _, name := range lockfile.Names() {
+				entry := lockfile.Templates[name]
+				locked, err := fetchLocked(client, nil, name, entry)
+				if err != nil {
+					return fail(clierr.Internal, i18n.Errorf("템플릿 '%s' 설치 실패: %w", name, err))
+				}
+				if err := checkSignature(cmd, locked); err != nil {
+					return fail(clierr.Integrity, err)
+				}
+
+				updated, kept, err := writeChangedFiles(cmd, locked.Template, installed.Templates[name].Files)
+				if err != nil {
+					return fail(clierr.IO, i18n.Errorf("템플릿 '%s' 저장 실패: %w", name, err))
+				}
+				summary.Templates = append(summary.Templates, installedTemplate{
+					Name: name, Revision: entry.Revision, Version: entry.Version, Updated: updated, Kept: kept,
+				})
+			}
+		}
+
+		// 이전에 설치했지만 잠금 파일에 없는 파일 정리
+		stale := installed.StaleFiles(lockfile)
+		for _, pruned := range summary.Pruned {
+			for _, path := range append(pruned.Removed, pruned.Kept...) {
+				delete(stale, path)
+			}
+		}
+		summary.Removed, summary.Kept, err = filesystem.RemoveUnchangedFiles(stale)
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+		if err := lockfile.SaveInstalled(projectDir); err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		return printResult(summary, func() {
+			for _, installed := range summary.Templates {
+				i18n.Printf("%s %s: %d개 파일 갱신\n", installed.Name, describeLock(installed.Revision, installed.Version), len(installed.Updated))
+				for _, path := range installed.Kept {
+					i18n.Printf("유지: %s (로컬에서 수정됨)\n", path)
+				}
+			}
+			for _, pruned := range summary.Pruned {
+				for _, path := range pruned.Kept {
+					i18n.Printf("유지: %s (내용이 잠금 파일과 다름)\n", path)
+				}
+				i18n.Printf("%s: %s에 없어 제거 (%d개 파일 삭제)\n", pruned.Name, project.FileName, len(pruned.Removed))
+			}
+			for _, path := range summary.Removed {
+				i18n.Printf("삭제: %s (%s에 없음)\n", path, lock.FileName)
+			}
+			for _, path := range summary.Kept {
+				i18n.Printf("유지: %s (로컬에서 수정됨)\n", path)
+			}
+		})
+	},
+}
+
+// lockRef 잠금 파일에 기록된 수정 이력과 배포 버전
+type lockRef struct {
+	Revision string `json:"revision"`
+	Version  string `json:"version,omitempty"`
+}
+
+// fileChange 두 수정 이력 사이에서 바뀐 파일
+type fileChange struct {
+	Path   string `json:"path"`
+	Status string `json:"status"` // added, modified, deleted
+	Diff   string `json:"diff,omitempty"`
+}
+
+// updateResult update 명령에서 템플릿 하나의 갱신 결과
+type updateResult struct {
+	Name     string       `json:"name"`
+	From     lockRef      `json:"from"`
+	To       lockRef      `json:"to"`
+	UpToDate bool         `json:"up_to_date"`
+	Applied  bool         `json:"applied"` // --dry-run이면 false
+	Changes  []fileChange `json:"changes"`
+	Kept     []string     `json:"kept,omitempty"` // 로컬에서 수정되어 갱신하거나 삭제하지 않은 파일
+}
+
+var updateCmd = &cobra.Command{
+	Use:   "update [name]",
+	Short: "잠금 파일의 템플릿을 최신 수정 이력으로 갱신",
+	Long: "잠금 파일에 기록된 템플릿(또는 지정한 템플릿)을 최신 수정 이력으로 갱신하고 변경 내용을 보여줍니다.\n" +
+		".cursorrules.yaml에 선언된 템플릿은 선언한 버전 조건 안에서 갱신하고 변수와 ignore를 적용해 씁니다.\n" +
+		"새 수정 이력에서 빠진 파일은 삭제하며, 로컬에서 수정한 파일은 충돌 처리 방식(--force, --merge, conflict_policy)에 따라 처리합니다.",
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		projectDir, err := filesystem.GetProjectDir()
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		lockfile, err := lock.Load(projectDir)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+		installed, err := lock.LoadInstalled(projectDir)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+		declared, err := project.Load(projectDir)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+
+		names := lockfile.Names()
+		if len(args) == 1 {
+			name := config.QualifyTemplateName(args[0])
+			if _, ok := lockfile.Templates[name]; !ok {
+				return fail(clierr.NotFound, i18n.Errorf("%s에 '%s' 템플릿이 없습니다.", lock.FileName, name))
+			}
+			names = []string{name}
+		}
+		if len(names) == 0 {
+			return fail(clierr.NotFound, i18n.Errorf("%s에 기록된 템플릿이 없습니다.", lock.FileName))
+		}
+
+		client, err := gist.NewGistClient()
+		if err != nil {
+			return fail(clierr.Auth, i18n.Errorf("Gist 클라이언트 생성 실패: %w", err))
+		}
+
+		results := make([]updateResult, 0, len(names))
+		for _, name := range names {
+			entry := lockfile.Templates[name]
+
+			// 선언한 버전 조건이 있으면 그 안에서, 배포 버전으로 설치한 템플릿은 최신 배포 버전으로,
+			// 그 외에는 최신 수정 이력으로 갱신
+			ref := ""
+			if spec, ok := declaredSpec(declared, name); ok && spec.Version != "" {
+				ref = spec.Version
+			} else if entry.Version != "" {
+				ref = "latest"
+			}
+			remote, err := fetchTemplate(client, name, ref)
+			if err != nil {
+				return fail(clierr.Internal, err)
+			}
+
+			result := updateResult{
+				Name:    name,
+				From:    lockRef{Revision: entry.Revision, Version: entry.Version},
+				To:      lockRef{Revision: remote.Revision, Version: remote.Version},
+				Changes: []fileChange{},
+			}
+			if remote.Revision == entry.Revision {
+				result.UpToDate = true
+				results = append(results, result)
+				continue
+			}
+
+			current, err := fetchLocked(client, declared, name, entry)
+			if err != nil {
+				return fail(clierr.Internal, i18n.Errorf("템플릿 '%s' 조회 실패: %w", name, err))
+			}
+			before, _ := renderDeclared(declared, name, current.Template)
+			after, _ := renderDeclared(declared, name, remote.Template)
+			result.Changes = templateChanges(before, after)
+
+			if !dryRun {
+				if err := checkSignature(cmd, remote); err != nil {
+					return fail(clierr.Integrity, err)
+				}
+
+				kept, err := applyUpdate(cmd, entry, after, result.Changes)
+				if err != nil {
+					return fail(clierr.IO, i18n.Errorf("템플릿 '%s' 저장 실패: %w", name, err))
+				}
+				result.Kept = kept
+				lockfile.Templates[name] = lock.NewEntry(remote.GistID, remote.Revision, remote.Version, after)
+				installed.Templates[name] = lockfile.Templates[name]
+				result.Applied = true
+			}
+			results = append(results, result)
+		}
+
+		if !dryRun {
+			if err := lockfile.Save(projectDir); err != nil {
+				return fail(clierr.IO, err)
+			}
+			if err := installed.SaveInstalled(projectDir); err != nil {
+				return fail(clierr.IO, err)
+			}
+		}
+
+		return printResult(results, func() {
+			for _, result := range results {
+				if result.UpToDate {
+					i18n.Printf("%s: 이미 최신입니다. (%s)\n", result.Name, shortRevision(result.From.Revision))
+					continue
+				}
+				fmt.Printf("%s: %s → %s\n", result.Name,
+					describeLock(result.From.Revision, result.From.Version), describeLock(result.To.Revision, result.To.Version))
+				printChanges(result.Changes)
+				for _, path := range result.Kept {
+					i18n.Printf("유지: %s (로컬에서 수정됨)\n", path)
+				}
+			}
+		})
+	},
+}
+
+// installDeclared .cursorrules.yaml에 선언된 템플릿을 설치하고 잠금 파일 갱신
+func installDeclared(cmd *cobra.Command, client *gist.GistClient, projectDir string, declared *project.File, lockfile, installed *lock.Lockfile, summary *installSummary) error {
+	for _, name := range declared.Names() {
+		spec := declared.Templates[name]
+
+		// 잠금 파일의 수정 이력이 선언한 버전을 만족하면 그대로 사용
+		var remote *remoteTemplate
+		var err error
+		entry, locked := lockfile.Templates[name]
+		resolved := !locked || !lockSatisfies(spec, entry)
+		if resolved {
+			remote, err = fetchTemplate(client, name, spec.Version)
+			if err != nil {
+				return err
+			}
+		} else {
+			remote, err = fetchLocked(client, declared, name, entry)
+			if err != nil {
+				return i18n.Errorf("템플릿 '%s' 설치 실패: %w", name, err)
+			}
+		}
+		if err := checkSignature(cmd, remote); err != nil {
+			return err
+		}
+
+		// 잠금 파일에는 변수를 채우고 무시한 파일을 뺀, 실제로 쓰는 파일의 해시를 기록
+		rendered, skipped := spec.Render(remote.Template)
+		if resolved {
+			lockfile.Templates[name] = lock.NewEntry(remote.GistID, remote.Revision, remote.Version, rendered)
+		}
+		for _, path := range skipped {
+			output.Printf("무시됨: %s (%s)\n", path, project.FileName)
+		}
+
+		updated, kept, err := writeChangedFiles(cmd, rendered, installed.Templates[name].Files)
+		if err != nil {
+			return clierr.Default(clierr.IO, i18n.Errorf("템플릿 '%s' 저장 실패: %w", name, err))
+		}
+		summary.Templates = append(summary.Templates, installedTemplate{
+			Name: name, Revision: remote.Revision, Version: remote.Version, Updated: updated, Kept: kept, Ignored: skipped,
+		})
+	}
+
+	// 선언에서 빠진 템플릿 정리
+	for _, name := range lockfile.Names() {
+		if _, ok := declared.Templates[name]; ok {
+			continue
+		}
+
+		removed, kept, err := filesystem.RemoveUnchangedFiles(lockfile.Templates[name].Files)
+		if err != nil {
+			return clierr.Default(clierr.IO, i18n.Errorf("템플릿 '%s' 정리 실패: %w", name, err))
+		}
+		delete(lockfile.Templates, name)
+		summary.Pruned = append(summary.Pruned, prunedTemplate{Name: name, Removed: removed, Kept: kept})
+	}
+
+	return clierr.Default(clierr.IO, lockfile.Save(projectDir))
+}
+
+// lockSatisfies 잠금 정보가 선언한 버전 조건을 만족하는지 확인
+func lockSatisfies(spec project.Spec, entry lock.Entry) bool {
+	switch {
+	case spec.Version == "":
+		return true
+	case models.IsRevision(spec.Version):
+		return strings.HasPrefix(entry.Revision, spec.Version)
+	}
+
+	constraint, err := models.ParseConstraint(spec.Version)
+	if err != nil || entry.Version == "" {
+		return false
+	}
+	version, err := models.ParseSemVer(entry.Version)
+	if err != nil {
+		return false
+	}
+	return constraint.Check(version)
+}
+
+// declaredSpec 선언 파일에서 템플릿 설정 조회 (선언 파일이 없거나 선언되지 않았으면 false)
+func declaredSpec(declared *project.File, name string) (project.Spec, bool) {
+	if declared == nil {
+		return project.Spec{}, false
+	}
+	spec, ok := declared.Templates[name]
+	return spec, ok
+}
+
+// renderDeclared 선언된 템플릿이면 선언의 변수와 ignore를 적용한 템플릿과 제외한 파일 반환
+// 선언되지 않은 템플릿은 그대로 반환한다.
+func renderDeclared(declared *project.File, name string, template *models.Template) (*models.Template, []string) {
+	spec, ok := declaredSpec(declared, name)
+	if !ok {
+		return template, nil
+	}
+	return spec.Render(template)
+}
+
+// fetchLocked 잠금 정보에 기록된 수정 이력의 템플릿을 조회하고 해시 검증
+// 잠금 파일에는 실제로 쓴 파일의 해시가 기록되므로, 선언된 템플릿은 선언을 적용한 내용으로 검증한다.
+// 반환하는 템플릿은 선언을 적용하기 전의 원격 내용이다.
+func fetchLocked(client *gist.GistClient, declared *project.File, name string, entry lock.Entry) (*remoteTemplate, error) {
+	if entry.Backend != lock.BackendGist {
+		return nil, clierr.New(clierr.Validation, "지원하지 않는 저장소입니다: %s", entry.Backend)
+	}
+
+	contents, err := client.GetRevisionContent(entry.ID, entry.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := &remoteTemplate{GistID: entry.ID, Revision: entry.Revision, Version: entry.Version}
+	if err := loadRemoteContents(remote, name, contents); err != nil {
+		return nil, err
+	}
+
+	rendered, _ := renderDeclared(declared, name, remote.Template)
+	if mismatched := entry.Verify(rendered); len(mismatched) > 0 {
+		return nil, clierr.New(clierr.Integrity, "잠금 파일의 해시와 일치하지 않는 파일이 있습니다: %s", strings.Join(mismatched, ", "))
+	}
+
+	return remote, nil
+}
+
+// writeChangedFiles 로컬과 내용이 다른 파일만 저장하고, 저장한 파일과 유지한 파일 경로 반환
+// installed(이 컴퓨터에 마지막으로 설치한 해시)와 로컬 파일이 다르면 로컬에서 수정한 것으로 보고
+// applyUpdate와 같이 충돌 처리 방식에 따라 덮어쓰거나 유지한다.
+func writeChangedFiles(cmd *cobra.Command, template *models.Template, installed map[string]string) ([]string, []string, error) {
+	changed, err := filesystem.ChangedFiles(template)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(changed.Files) == 0 {
+		return []string{}, nil, nil
+	}
+
+	var conflicts []string
+	for path, rule := range changed.Files {
+		modified, err := locallyModified(path, installed[path], rule.Content)
+		if err != nil {
+			return nil, nil, err
+		}
+		if modified {
+			conflicts = append(conflicts, path)
+		}
+	}
+
+	keep, ok, err := resolveConflicts(cmd, conflicts,
+		"다음 파일이 로컬에서 수정되었습니다 (덮어쓰면 .bak으로 백업됩니다):\n", "로컬에서 수정된 파일이 있어 설치를 중단합니다: %s")
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, clierr.New(clierr.Canceled, "설치가 취소되었습니다.")
+	}
+	for _, path := range keep {
+		changed.RemoveFile(path)
+	}
+
+	if err := filesystem.SaveLocalTemplate(changed, nil); err != nil {
+		return nil, nil, err
+	}
+	return templatePaths(changed), keep, nil
+}
+
+// applyUpdate 두 수정 이력 사이에서 바뀐 파일만 로컬에 반영
+// 새로 쓰거나 삭제할 파일 중 로컬에서 수정한 파일(잠금 파일의 해시와 다른 파일)은 충돌로 보고
+// 충돌 처리 방식에 따라 덮어쓰거나 유지한다. 삭제할 파일은 로컬에서 수정하지 않았을 때만 삭제한다.
+// 갱신하거나 삭제하지 않고 유지한 파일 목록을 반환한다.
+func applyUpdate(cmd *cobra.Command, entry lock.Entry, template *models.Template, changes []fileChange) ([]string, error) {
+	changed := models.NewTemplate(template.Name, template.Description)
+	deleted := make(map[string]string)
+	var conflicts []string
+	for _, change := range changes {
+		if change.Status == gist.FileDeleted {
+			deleted[change.Path] = entry.Files[change.Path]
+			continue
+		}
+
+		rule := template.Files[change.Path]
+		changed.Files[change.Path] = rule
+		modified, err := locallyModified(change.Path, entry.Files[change.Path], rule.Content)
+		if err != nil {
+			return nil, err
+		}
+		if modified {
+			conflicts = append(conflicts, change.Path)
+		}
+	}
+
+	keep, ok, err := resolveConflicts(cmd, conflicts,
+		"다음 파일이 로컬에서 수정되었습니다 (덮어쓰면 .bak으로 백업됩니다):\n", "로컬에서 수정된 파일이 있어 갱신을 중단합니다: %s")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, clierr.New(clierr.Canceled, "갱신이 취소되었습니다.")
+	}
+	for _, path := range keep {
+		changed.RemoveFile(path)
+	}
+
+	if err := filesystem.SaveLocalTemplate(changed, nil); err != nil {
+		return nil, err
+	}
+	_, kept, err := filesystem.RemoveUnchangedFiles(deleted)
+	if err != nil {
+		return nil, err
+	}
+
+	kept = append(kept, keep...)
+	sort.Strings(kept)
+	return kept, nil
+}
+
+// locallyModified 로컬 파일이 설치한 뒤 수정되었는지 확인
+// 잠금 파일에 해시가 없는 새 파일은 로컬에 다른 내용의 파일이 있으면 수정된 것으로 본다.
+func locallyModified(path, lockedHash, newContent string) (bool, error) {
+	content, err := filesystem.ReadRuleFile(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if lockedHash == "" {
+		return content != newContent, nil
+	}
+	return models.HashContent(content) != lockedHash, nil
+}
+
+// recordLock 잠금 파일에 템플릿 설치 정보 기록
+func recordLock(name string, entry lock.Entry) error {
+	projectDir, err := filesystem.GetProjectDir()
+	if err != nil {
+		return err
+	}
+
+	lockfile, err := lock.Load(projectDir)
+	if err != nil {
+		return err
+	}
+
+	lockfile.Templates[name] = entry
+	if err := lockfile.Save(projectDir); err != nil {
+		return err
+	}
+
+	installed, err := lock.LoadInstalled(projectDir)
+	if err != nil {
+		return err
+	}
+	installed.Templates[name] = entry
+	return installed.SaveInstalled(projectDir)
+}
+
+// templateChanges 두 템플릿의 파일별 차이
+func templateChanges(before, after *models.Template) []fileChange {
+	paths := make(map[string]bool)
+	for path := range before.Files {
+		paths[path] = true
+	}
+	for path := range after.Files {
+		paths[path] = true
+	}
+
+	sorted := make([]string, 0, len(paths))
+	for path := range paths {
+		sorted = append(sorted, path)
+	}
+	sort.Strings(sorted)
+
+	changes := []fileChange{}
+	for _, path := range sorted {
+		oldRule, hadOld := before.Files[path]
+		newRule, hasNew := after.Files[path]
+		switch {
+		case !hadOld:
+			changes = append(changes, fileChange{Path: path, Status: gist.FileAdded})
+		case !hasNew:
+			changes = append(changes, fileChange{Path: path, Status: gist.FileDeleted})
+		case oldRule.Content != newRule.Content:
+			changes = append(changes, fileChange{
+				Path:   path,
+				Status: gist.FileModified,
+				Diff:   diff.Unified(oldRule.Content, newRule.Content, "a/"+path, "b/"+path),
+			})
+		}
+	}
+	return changes
+}
+
+// printChanges 파일별 차이 출력
+func printChanges(changes []fileChange) {
+	for _, change := range changes {
+		switch change.Status {
+		case gist.FileAdded:
+			i18n.Printf("  추가: %s\n", change.Path)
+		case gist.FileDeleted:
+			i18n.Printf("  삭제: %s\n", change.Path)
+		default:
+			i18n.Printf("  변경: %s\n", change.Path)
+			fmt.Print(colorizeDiff(change.Diff))
+		}
+	}
+}
+
+// describeLock 수정 이력과 배포 버전 표시
+func describeLock(revision, version string) string {
+	if version != "" {
+		return fmt.Sprintf("%s (%s)", version, shortRevision(revision))
+	}
+	return shortRevision(revision)
+}
+
+// shortRevision 수정 이력 SHA 앞 7자리
+func shortRevision(revision string) string {
+	return revision[:min(7, len(revision))]
+}
+
+// useColor 색상 출력 여부 (color 설정이 auto이면 터미널이고 NO_COLOR가 없을 때만)
+func useColor() bool {
+	switch config.GetColor() {
+	case config.ColorAlways:
+		return true
+	case config.ColorNever:
+		return false
+	}
+	return os.Getenv("NO_COLOR") == "" && terminal.IsTerminal(os.Stdout)
+}
+
+// colorizeDiff unified diff의 추가/삭제/hunk 줄에 색상 적용
+func colorizeDiff(text string) string {
+	if text == "" || !useColor() {
+		return text
+	}
+
+	lines := strings.SplitAfter(text, "\n")
+	for i, line := range lines {
+		var color string
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			color = "\033[1m"
+		case strings.HasPrefix(line, "@@"):
+			color = "\033[36m"
+		case strings.HasPrefix(line, "+"):
+			color = "\033[32m"
+		case strings.HasPrefix(line, "-"):
+			color = "\033[31m"
+		default:
+			continue
+		}
+		body := strings.TrimSuffix(line, "\n")
+		lines[i] = color + body + "\033[0m" + line[len(body):]
+	}
+	return strings.Join(lines, "")
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+func TestWriteChangedFilesConflicts(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+
+	const (
+		original = "설치한 내용\n"
+		modified = "로컬에서 수정한 내용\n"
+		remote   = "잠금 파일의 새 내용\n"
+	)
+	installed := map[string]string{"a.mdc": models.HashContent(original)}
+
+	tests := []struct {
+		name        string
+		local       string
+		installed   map[string]string
+		flag        string
+		wantErr     bool
+		wantContent string
+		wantUpdated []string
+		wantKept    []string
+	}{
+		{name: "수정하지 않은 파일은 덮어씀", local: original, installed: installed, wantContent: remote, wantUpdated: []string{"a.mdc"}},
+		{name: "수정한 파일은 확인 없이 덮어쓰지 않음", local: modified, installed: installed, wantErr: true, wantContent: modified},
+		{name: "설치 기록이 없는 다른 내용의 파일", local: modified, wantErr: true, wantContent: modified},
+		{name: "--merge이면 유지", local: modified, installed: installed, flag: "merge", wantContent: modified, wantUpdated: []string{}, wantKept: []string{"a.mdc"}},
+		{name: "--force이면 백업하고 덮어씀", local: modified, installed: installed, flag: "force", wantContent: remote, wantUpdated: []string{"a.mdc"}},
+		{name: "로컬과 같은 내용", local: remote, installed: installed, wantContent: remote, wantUpdated: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Chdir(dir)
+			path := filepath.Join(dir, ".cursor", "rules", "a.mdc")
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(tt.local), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			cmd := &cobra.Command{}
+			cmd.Flags().Bool("force", false, "")
+			cmd.Flags().Bool("merge", false, "")
+			if tt.flag != "" {
+				cmd.Flags().Set(tt.flag, "true")
+			}
+
+			template := models.NewTemplate("test", "")
+			template.AddFile("a.mdc", remote, "a.mdc")
+			updated, kept, err := writeChangedFiles(cmd, template, tt.installed)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("writeChangedFiles() = %v, %v, want error", updated, kept)
+				}
+			} else if err != nil {
+				t.Fatalf("writeChangedFiles(): %v", err)
+			} else if strings.Join(updated, ",") != strings.Join(tt.wantUpdated, ",") || strings.Join(kept, ",") != strings.Join(tt.wantKept, ",") {
+				t.Errorf("writeChangedFiles() = %v, %v, want %v, %v", updated, kept, tt.wantUpdated, tt.wantKept)
+			}
+
+			content, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(content) != tt.wantContent {
+				t.Errorf("a.mdc = %q, want %q", content, tt.wantContent)
+			}
+			if tt.flag == "force" {
+				if backup, err := os.ReadFile(path + ".bak"); err != nil || string(backup) != tt.local {
+					t.Errorf("a.mdc.bak = %q, %v, want %q", backup, err, tt.local)
+				}
+			}
+		})
+	}
+}
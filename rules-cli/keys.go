@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/signing"
+)
+
+var keysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "템플릿 서명 키 관리",
+}
+
+var keysGenerateCmd = &cobra.Command{
+	Use:   "generate [name]",
+	Short: "새 서명 키 생성",
+	Long:  "ed25519 서명 키를 생성해 ~/.cursorrules/keys에 저장하고, 공개 키를 신뢰 목록에 추가합니다.",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := config.GetSigningKey()
+		if len(args) == 1 {
+			name = args[0]
+		}
+
+		pub, err := signing.GenerateKey(name)
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		// 자신이 서명한 템플릿은 바로 검증할 수 있도록 신뢰 목록에 추가
+		if err := signing.AddTrustedKey(pub, name); err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		result := keyInfo{ID: signing.KeyID(pub), Comment: name, PublicKey: signing.FormatPublicKey(pub, name)}
+		return printResult(result, func() {
+			i18n.Printf("서명 키 '%s'이(가) 생성되었습니다. (키 ID: %s)\n", name, result.ID)
+			i18n.Printf("팀원에게 아래 공개 키를 공유해 'cursorrules keys trust'로 등록하도록 하세요:\n")
+			fmt.Println(result.PublicKey)
+		})
+	},
+}
+
+var keysTrustCmd = &cobra.Command{
+	Use:   "trust [public-key|file]",
+	Short: "공개 키를 신뢰 목록에 추가",
+	Long:  "\"ed25519 <base64> <설명>\" 형식의 공개 키 또는 공개 키 파일(.pub)을 신뢰 목록에 추가합니다.",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		line := strings.Join(args, " ")
+		if data, err := os.ReadFile(args[0]); err == nil {
+			line = strings.TrimSpace(string(data))
+		}
+
+		pub, comment, err := signing.ParsePublicKey(line)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+
+		if err := signing.AddTrustedKey(pub, comment); err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		result := keyInfo{ID: signing.KeyID(pub), Comment: comment, PublicKey: signing.FormatPublicKey(pub, comment)}
+		return printResult(result, func() {
+			i18n.Printf("공개 키가 신뢰 목록에 추가되었습니다. (키 ID: %s)\n", result.ID)
+		})
+	},
+}
+
+var keysListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "신뢰하는 공개 키 목록 출력",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		keys, err := signing.LoadTrustedKeys()
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		result := make([]keyInfo, 0, len(keys))
+		for _, key := range keys {
+			result = append(result, keyInfo{ID: key.ID, Comment: key.Comment, PublicKey: signing.FormatPublicKey(key.Key, key.Comment)})
+		}
+		return printResult(result, func() {
+			if len(result) == 0 {
+				i18n.Printf("신뢰하는 공개 키가 없습니다.\n")
+				return
+			}
+			for _, key := range result {
+				fmt.Printf("- %s %s\n", key.ID, key.Comment)
+			}
+		})
+	},
+}
+
+// keyInfo keys 명령의 공개 키 정보
+type keyInfo struct {
+	ID        string `json:"id"`
+	Comment   string `json:"comment"`
+	PublicKey string `json:"public_key"` // "ed25519 <base64> <설명>" 형식
+}
+
+func init() {
+	keysCmd.AddCommand(keysGenerateCmd)
+	keysCmd.AddCommand(keysTrustCmd)
+	keysCmd.AddCommand(keysListCmd)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/convert"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+// localesDir 사용자 메시지 카탈로그(<언어>.json)를 두는 디렉토리 (설정 디렉토리 기준)
+const localesDir = "locales"
+
+// 지원 형식 목록이 들어가는 도움말 (번역한 뒤 형식 목록을 채운다)
+const (
+	exportLong        = "로컬 규칙을 다른 AI 어시스턴트의 지침 파일 형식으로 변환해 프로젝트 루트에 저장합니다.\n지원 형식: %s"
+	importLong        = "프로젝트 루트의 다른 AI 어시스턴트 지침 파일을 .cursor/rules 규칙으로 변환합니다.\n지원 형식: %s"
+	exportFormatUsage = "내보낼 형식 (%s)"
+	importFormatUsage = "가져올 형식 (%s)"
+)
+
+// setupLanguage 메시지 언어를 정하고 명령 도움말 번역
+// language 설정 → LC_ALL, LC_MESSAGES, LANG 순서로 언어를 정한다.
+func setupLanguage() {
+	if configDir, err := config.GetConfigDir(); err == nil {
+		if err := i18n.LoadDir(filepath.Join(configDir, localesDir)); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}
+	i18n.SetLanguage(i18n.Detect(config.GetLanguage()))
+
+	localizeCommand(rootCmd)
+
+	formats := strings.Join(convert.Names(), ", ")
+	exportCmd.Long = i18n.Sprintf(exportLong, formats)
+	importCmd.Long = i18n.Sprintf(importLong, formats)
+	exportCmd.Flags().Lookup("format").Usage = i18n.Sprintf(exportFormatUsage, formats)
+	importCmd.Flags().Lookup("format").Usage = i18n.Sprintf(importFormatUsage, formats)
+}
+
+// localizeCommand 명령과 하위 명령의 설명, 옵션 설명 번역
+func localizeCommand(cmd *cobra.Command) {
+	cmd.Short = i18n.T(cmd.Short)
+	cmd.Long = i18n.T(cmd.Long)
+
+	translate := func(flag *pflag.Flag) {
+		flag.Usage = i18n.T(flag.Usage)
+	}
+	cmd.LocalFlags().VisitAll(translate)
+	cmd.PersistentFlags().VisitAll(translate)
+
+	for _, child := range cmd.Commands() {
+		localizeCommand(child)
+	}
+}
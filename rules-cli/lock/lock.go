@@ -0,0 +1,167 @@
+package lock
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+const (
+	// FileName 프로젝트 루트에 저장되는 잠금 파일 이름
+	FileName = "cursorrules.lock"
+	// InstalledFile 이 컴퓨터에 마지막으로 설치한 상태를 기록하는 파일 (프로젝트 루트 기준, 커밋하지 않음)
+	// 잠금 파일이 바뀐 뒤 install을 실행하면 더 이상 잠금 파일에 없는 파일을 찾는 데 사용한다.
+	InstalledFile = ".cursor/cursorrules.installed"
+	// formatVersion 잠금 파일 형식 버전
+	formatVersion = 1
+	// BackendGist Gist 저장소
+	BackendGist = "gist"
+)
+
+// Entry 설치된 템플릿 하나의 고정 정보
+type Entry struct {
+	Backend  string            `json:"backend"`           // 저장소 종류 (gist)
+	ID       string            `json:"id"`                // 저장소에서의 템플릿 ID (Gist ID)
+	Revision string            `json:"revision"`          // 설치한 수정 이력 SHA
+	Version  string            `json:"version,omitempty"` // 설치한 배포 버전 (있는 경우)
+	Files    map[string]string `json:"files"`             // 파일별 해시 (models.HashContent)
+}
+
+// Lockfile 프로젝트에 설치된 템플릿의 정확한 수정 이력 기록
+type Lockfile struct {
+	Version   int              `json:"lockfile_version"`
+	Templates map[string]Entry `json:"templates"`
+}
+
+// Load 프로젝트 디렉토리의 잠금 파일 로드 (없으면 빈 잠금 파일)
+func Load(projectDir string) (*Lockfile, error) {
+	return load(filepath.Join(projectDir, FileName))
+}
+
+// LoadInstalled 이 컴퓨터에 마지막으로 설치한 상태 로드 (없으면 빈 상태)
+func LoadInstalled(projectDir string) (*Lockfile, error) {
+	return load(filepath.Join(projectDir, InstalledFile))
+}
+
+// load 잠금 파일 형식의 파일 로드 (없으면 빈 잠금 파일)
+func load(path string) (*Lockfile, error) {
+	lockfile := &Lockfile{
+		Version:   formatVersion,
+		Templates: make(map[string]Entry),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return lockfile, nil
+		}
+		return nil, i18n.Errorf("잠금 파일 읽기 실패: %w", err)
+	}
+
+	if err := json.Unmarshal(data, lockfile); err != nil {
+		return nil, i18n.Errorf("잠금 파일 파싱 실패: %w", err)
+	}
+	if lockfile.Version > formatVersion {
+		return nil, i18n.Errorf("지원하지 않는 잠금 파일 형식입니다 (버전 %d). CLI를 업데이트하세요", lockfile.Version)
+	}
+	if lockfile.Templates == nil {
+		lockfile.Templates = make(map[string]Entry)
+	}
+
+	return lockfile, nil
+}
+
+// Save 잠금 파일 저장
+func (l *Lockfile) Save(projectDir string) error {
+	return l.save(filepath.Join(projectDir, FileName))
+}
+
+// SaveInstalled 이 컴퓨터에 설치한 상태로 저장
+func (l *Lockfile) SaveInstalled(projectDir string) error {
+	path := filepath.Join(projectDir, InstalledFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return i18n.Errorf("잠금 파일 저장 실패: %w", err)
+	}
+	return l.save(path)
+}
+
+// save 잠금 파일 형식으로 저장
+func (l *Lockfile) save(path string) error {
+	l.Version = formatVersion
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return i18n.Errorf("잠금 파일 변환 실패: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return i18n.Errorf("잠금 파일 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// Names 잠금 파일에 기록된 템플릿 이름 (정렬)
+func (l *Lockfile) Names() []string {
+	names := make([]string, 0, len(l.Templates))
+	for name := range l.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// StaleFiles 이 잠금 파일에는 있지만 target의 어떤 템플릿에도 없는 파일과 기록된 해시
+func (l *Lockfile) StaleFiles(target *Lockfile) map[string]string {
+	wanted := make(map[string]bool)
+	for _, entry := range target.Templates {
+		for filePath := range entry.Files {
+			wanted[filePath] = true
+		}
+	}
+
+	stale := make(map[string]string)
+	for _, entry := range l.Templates {
+		for filePath, hash := range entry.Files {
+			if !wanted[filePath] {
+				stale[filePath] = hash
+			}
+		}
+	}
+	return stale
+}
+
+// NewEntry 설치한 템플릿으로 잠금 정보 생성
+func NewEntry(id, revision, version string, template *models.Template) Entry {
+	entry := Entry{
+		Backend:  BackendGist,
+		ID:       id,
+		Revision: revision,
+		Version:  version,
+		Files:    make(map[string]string),
+	}
+	for filePath, rule := range template.Files {
+		entry.Files[filePath] = models.HashContent(rule.Content)
+	}
+	return entry
+}
+
+// Verify 템플릿 파일이 잠금 정보의 해시와 일치하는지 확인하고, 일치하지 않는 파일 목록 반환
+func (e Entry) Verify(template *models.Template) []string {
+	var mismatched []string
+	for filePath, hash := range e.Files {
+		rule, ok := template.Files[filePath]
+		if !ok || models.HashContent(rule.Content) != hash {
+			mismatched = append(mismatched, filePath)
+		}
+	}
+	for filePath := range template.Files {
+		if _, ok := e.Files[filePath]; !ok {
+			mismatched = append(mismatched, filePath)
+		}
+	}
+	sort.Strings(mismatched)
+	return mismatched
+}
@@ -0,0 +1,83 @@
+package lock
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tinysolver/rules-cli/models"
+)
+
+func newTemplate(files map[string]string) *models.Template {
+	template := models.NewTemplate("test", "")
+	for name, content := range files {
+		template.AddFile(name, content, name)
+	}
+	return template
+}
+
+func TestEntryVerify(t *testing.T) {
+	entry := NewEntry("id", "rev", "", newTemplate(map[string]string{
+		"a.mdc": "a",
+		"b.mdc": "b",
+	}))
+
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []string
+	}{
+		{"일치", map[string]string{"a.mdc": "a", "b.mdc": "b"}, nil},
+		{"내용 변경", map[string]string{"a.mdc": "a", "b.mdc": "x"}, []string{"b.mdc"}},
+		{"파일 누락", map[string]string{"a.mdc": "a"}, []string{"b.mdc"}},
+		{"기록되지 않은 파일", map[string]string{"a.mdc": "a", "b.mdc": "b", "c.mdc": "c"}, []string{"c.mdc"}},
+		{"여러 파일", map[string]string{"a.mdc": "x", "c.mdc": "c"}, []string{"a.mdc", "b.mdc", "c.mdc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := entry.Verify(newTemplate(tt.files)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStaleFiles(t *testing.T) {
+	installed := &Lockfile{Templates: map[string]Entry{
+		"a": {Files: map[string]string{"a.mdc": "sha256:a", "shared.mdc": "sha256:s"}},
+		"b": {Files: map[string]string{"b.mdc": "sha256:b"}},
+	}}
+	target := &Lockfile{Templates: map[string]Entry{
+		"a": {Files: map[string]string{"a.mdc": "sha256:a2"}},
+		"c": {Files: map[string]string{"shared.mdc": "sha256:s"}},
+	}}
+
+	want := map[string]string{"b.mdc": "sha256:b"}
+	if got := installed.StaleFiles(target); !reflect.DeepEqual(got, want) {
+		t.Errorf("StaleFiles() = %v, want %v", got, want)
+	}
+}
+
+func TestLoadSaveInstalled(t *testing.T) {
+	dir := t.TempDir()
+
+	empty, err := LoadInstalled(dir)
+	if err != nil || len(empty.Templates) != 0 {
+		t.Fatalf("LoadInstalled() = %v, %v, want empty", empty, err)
+	}
+
+	lockfile := &Lockfile{Templates: map[string]Entry{
+		"a": NewEntry("id", "rev", "v1.0.0", newTemplate(map[string]string{"a.mdc": "a"})),
+	}}
+	if err := lockfile.SaveInstalled(dir); err != nil {
+		t.Fatalf("SaveInstalled(): %v", err)
+	}
+
+	loaded, err := LoadInstalled(dir)
+	if err != nil {
+		t.Fatalf("LoadInstalled(): %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Templates, lockfile.Templates) {
+		t.Errorf("LoadInstalled() = %v, want %v", loaded.Templates, lockfile.Templates)
+	}
+}
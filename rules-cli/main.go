@@ -1,275 +1,1182 @@
 package main
 
 import (
-	"bufio"
 	"fmt"
+	"maps"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"text/tabwriter"
+	"time"
 
+	"github.com/google/go-github/v58/github"
 	"github.com/spf13/cobra"
+	"github.com/tinysolver/rules-cli/bundle"
+	"github.com/tinysolver/rules-cli/clierr"
 	"github.com/tinysolver/rules-cli/config"
-	"github.com/tinysolver/rules-cli/gist"
+	"github.com/tinysolver/rules-cli/convert"
 	"github.com/tinysolver/rules-cli/filesystem"
+	"github.com/tinysolver/rules-cli/gist"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/lock"
 	"github.com/tinysolver/rules-cli/models"
+	"github.com/tinysolver/rules-cli/output"
+	"github.com/tinysolver/rules-cli/prompt"
+	"github.com/tinysolver/rules-cli/secrets"
+	"github.com/tinysolver/rules-cli/signing"
 )
 
 var rootCmd = &cobra.Command{
 	Use:   "cursorrules",
 	Short: "Cursor Rules CLI",
 	Long:  "Cursor Rules CLI는 터미널에서 Cursor rules 파일을 관리하는 도구입니다.",
-}
+	// 오류는 main에서 --output 형식에 맞춰 stderr로 출력
+	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// 인자 검증을 통과한 뒤의 실행 오류에는 사용법을 출력하지 않음
+		cmd.SilenceUsage = true
 
-var authCmd = &cobra.Command{
-	Use:   "auth",
-	Short: "GitHub Personal Access Token 설정",
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := config.InitConfig(); err != nil {
-			fmt.Printf("설정 초기화 실패: %v\n", err)
-			return
-		}
+		profile, _ := cmd.Flags().GetString("profile")
+		config.SetProfileOverride(profile)
 
-		reader := bufio.NewReader(os.Stdin)
-		fmt.Print("GitHub Personal Access Token을 입력하세요: ")
-		token, err := reader.ReadString('\n')
-		if err != nil {
-			fmt.Printf("토큰 입력 실패: %v\n", err)
-			return
-		}
+		// stdin이 터미널이 아니면 --no-input과 같이 질문하지 않음
+		yes, _ := cmd.Flags().GetBool("yes")
+		noInput, _ := cmd.Flags().GetBool("no-input")
+		prompt.Configure(yes, noInput)
 
-		token = strings.TrimSpace(token)
-		if err := config.SaveToken(token); err != nil {
-			fmt.Printf("토큰 저장 실패: %v\n", err)
-			return
+		// --output을 지정하지 않으면 output 설정을 따름
+		format, _ := cmd.Flags().GetString("output")
+		if format == "" {
+			format = config.GetOutputFormat()
 		}
-
-		configPath, err := config.GetConfigPath()
-		if err != nil {
-			fmt.Printf("설정 파일 경로 조회 실패: %v\n", err)
-			return
+		if err := output.SetFormat(format); err != nil {
+			return err
 		}
 
-		fmt.Printf("토큰이 %s에 저장되었습니다.\n", configPath)
+		// 어떤 계정으로 실행되는지 알 수 있도록 사용한 프로필 안내 (출력 결과와 섞이지 않도록 stderr)
+		// 구조화 출력은 스크립트가 읽으므로 안내하지 않는다. 프로필은 auth status 결과에 포함된다.
+		if !output.Structured() {
+			name, source := config.ActiveProfile()
+			i18n.Fprintf(os.Stderr, "프로필: %s (%s)\n", name, source)
+		}
+		return nil
 	},
 }
 
+// fail 명령 오류에 코드 지정 (err가 nil이면 nil)
+// 하위 패키지에서 코드를 지정하지 않은 오류에는 code를 사용한다.
+func fail(code clierr.Code, err error) error {
+	return clierr.Default(code, err)
+}
+
+// printResult 명령 결과를 --output 형식으로 출력 (table이면 table 함수 사용)
+func printResult(value interface{}, table func()) error {
+	return fail(clierr.Internal, output.Result(value, table))
+}
+
+// templateSummary list 명령의 템플릿 정보
+type templateSummary struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Files       int       `json:"files"`
+	Visibility  string    `json:"visibility"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Version     string    `json:"version,omitempty"`
+	Encrypted   *bool     `json:"encrypted,omitempty"` // --fast이면 알 수 없어 생략
+}
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "템플릿 목록 출력",
-	Run: func(cmd *cobra.Command, args []string) {
+	Long: "저장된 템플릿 목록을 템플릿마다 매니페스트를 조회해 설명, 최신 배포 버전, 암호화 여부와 함께 출력합니다.\n" +
+		"--fast 옵션을 사용하면 매니페스트를 조회하지 않고 Gist 목록만으로 빠르게 출력합니다.",
+	RunE: func(cmd *cobra.Command, args []string) error {
 		client, err := gist.NewGistClient()
 		if err != nil {
-			fmt.Printf("Gist 클라이언트 생성 실패: %v\n", err)
-			return
+			return fail(clierr.Auth, i18n.Errorf("Gist 클라이언트 생성 실패: %w", err))
 		}
 
 		gists, err := client.ListGists()
 		if err != nil {
-			fmt.Printf("Gist 목록 조회 실패: %v\n", err)
-			return
+			return fail(clierr.Network, err)
 		}
 
-		if len(gists) == 0 {
-			fmt.Println("저장된 템플릿이 없습니다.")
-			return
+		// 매니페스트의 설명, 최신 배포 버전, 암호화 여부는 Gist마다 내용을 조회해야 하므로 --fast이면 생략
+		fast, _ := cmd.Flags().GetBool("fast")
+		var contents []map[string]string
+		if !fast {
+			ids := make([]string, len(gists))
+			for i, g := range gists {
+				ids[i] = g.GetID()
+			}
+			contents, err = client.GetGistContents(ids)
+			if err != nil {
+				return fail(clierr.Network, err)
+			}
 		}
 
-		fmt.Println("저장된 템플릿 목록:")
-		for _, g := range gists {
-			name := gist.GetProjectName(g.GetDescription())
-			if name == "" {
-				name = "(이름 없음)"
+		summaries := make([]templateSummary, 0, len(gists))
+		for i, g := range gists {
+			summary := templateSummary{
+				ID:         g.GetID(),
+				Name:       gist.GetProjectName(g.GetDescription()),
+				Visibility: config.VisibilitySecret,
+				UpdatedAt:  g.GetUpdatedAt().Time,
+			}
+			if g.GetPublic() {
+				summary.Visibility = config.VisibilityPublic
+			}
+			for filename := range g.Files {
+				if filename != gist.ManifestFile && filename != gist.SignatureFile {
+					summary.Files++
+				}
+			}
+
+			if !fast {
+				encrypted := false
+				if manifest, _, err := gist.SplitManifest(contents[i]); err == nil && manifest != nil {
+					summary.Description = manifest.Description
+					if latest, ok := manifest.LatestRelease(); ok {
+						summary.Version = latest.Version
+					}
+					encrypted = manifest.Encryption != nil
+				}
+				summary.Encrypted = &encrypted
 			}
-			fmt.Printf("- %s\n", name)
+			summaries = append(summaries, summary)
 		}
+
+		return printResult(summaries, func() {
+			if len(summaries) == 0 {
+				i18n.Printf("저장된 템플릿이 없습니다.\n")
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			i18n.Fprintf(w, "이름\t버전\t파일\t공개\t수정 시간\tID\n")
+			for _, summary := range summaries {
+				name := summary.Name
+				if name == "" {
+					name = i18n.T("(이름 없음)")
+				}
+				version := summary.Version
+				if version == "" {
+					version = "-"
+				}
+				if summary.Encrypted != nil && *summary.Encrypted {
+					version += i18n.T(" [암호화]")
+				}
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\n", name, version, summary.Files, summary.Visibility,
+					summary.UpdatedAt.Local().Format("2006-01-02 15:04"), summary.ID)
+			}
+			w.Flush()
+		})
 	},
 }
 
 var downloadCmd = &cobra.Command{
-	Use:   "download [name]",
+	Use:   "download [name][@revision|@version]",
 	Short: "템플릿 다운로드",
-	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if len(args) != 1 {
-			fmt.Println("템플릿 이름을 지정해주세요")
-			return
-		}
-		templateName := args[0]
+	Long: "템플릿을 다운로드합니다. 이름 뒤에 @수정이력(SHA)을 붙이면 해당 시점의 템플릿을,\n" +
+		"@버전 조건(예: @^1.2, @~1.2.3, @v1.0.0)을 붙이면 조건에 맞는 가장 높은 배포 버전을 설치합니다.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		templateName, ref := parseTemplateRef(args[0])
 
 		client, err := gist.NewGistClient()
 		if err != nil {
-			fmt.Printf("Gist 클라이언트 생성 실패: %v\n", err)
-			return
+			return fail(clierr.Auth, i18n.Errorf("Gist 클라이언트 생성 실패: %w", err))
 		}
 
 		// Gist에서 템플릿 다운로드
-		gistObj, err := client.FindGistByDescription(templateName)
+		remote, err := fetchTemplate(client, templateName, ref)
 		if err != nil {
-			fmt.Printf("템플릿 다운로드 실패: %v\n", err)
-			return
+			return fail(clierr.Internal, err)
+		}
+
+		if err := checkSignature(cmd, remote); err != nil {
+			return fail(clierr.Integrity, err)
 		}
 
-		contents, err := client.GetGistContent(gistObj.GetID())
+		// 잠금 파일에는 병합으로 제외되는 파일을 포함한 전체 내용을 기록
+		entry := lock.NewEntry(remote.GistID, remote.Revision, remote.Version, remote.Template)
+
+		// 로컬에 저장
+		installed, err := installTemplate(cmd, remote.Template, remote.Manifest)
 		if err != nil {
-			fmt.Printf("템플릿 내용 조회 실패: %v\n", err)
-			return
+			return fail(clierr.IO, i18n.Errorf("템플릿 저장 실패: %w", err))
+		}
+		if !installed {
+			return fail(clierr.Canceled, i18n.NewError("설치가 취소되었습니다."))
 		}
 
-		// 템플릿 생성
-		template := &models.Template{
-			Files: make(map[string]models.Rule),
+		if err := recordLock(templateName, entry); err != nil {
+			return fail(clierr.IO, i18n.Errorf("잠금 파일 갱신 실패: %w", err))
 		}
 
-		// 각 파일을 템플릿에 추가
-		for filename, content := range contents {
-			// 파일 구조 보존을 위해 경로를 키로 사용
-			rule := models.Rule{
-				Name:    filename,
-				Content: content,
-				Path:    filename,
-			}
-			template.Files[filename] = rule
+		result := installResult{
+			Name:     templateName,
+			GistID:   remote.GistID,
+			Revision: remote.Revision,
+			Version:  remote.Version,
+			Files:    templatePaths(remote.Template),
+		}
+		return printResult(result, func() {
+			i18n.Printf("템플릿 '%s'이(가) 성공적으로 다운로드되었습니다.\n", args[0])
+		})
+	},
+}
+
+// installResult download, unpack 명령 결과
+type installResult struct {
+	Name     string   `json:"name"`
+	GistID   string   `json:"gist_id,omitempty"`
+	Revision string   `json:"revision,omitempty"`
+	Version  string   `json:"version,omitempty"`
+	Bundle   string   `json:"bundle,omitempty"`
+	Files    []string `json:"files"` // 설치한 파일 (병합으로 유지한 파일 제외)
+}
+
+// templatePaths 템플릿 파일 경로 (정렬)
+func templatePaths(template *models.Template) []string {
+	paths := make([]string, 0, len(template.Files))
+	for path := range template.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// parseTemplateRef "이름@참조" 형식의 인자를 이름과 참조로 분리
+// 참조는 Gist 수정 이력 SHA 또는 버전 조건(예: ^1.2)이다.
+// 이름에 네임스페이스가 없으면 현재 프로필의 네임스페이스를 붙인다.
+func parseTemplateRef(arg string) (string, string) {
+	name, ref, _ := strings.Cut(arg, "@")
+	return config.QualifyTemplateName(name), ref
+}
+
+// remoteTemplate Gist에서 조회한 템플릿
+type remoteTemplate struct {
+	Template *models.Template
+	Manifest *models.TemplateVersion // 매니페스트 (없으면 nil)
+	GistID   string
+	Revision string            // 조회한 수정 이력 SHA
+	Version  string            // 배포 버전 (버전 조건으로 조회한 경우)
+	Contents map[string]string // 원본 내용 (매니페스트, 서명, 암호화된 파일 포함)
+
+	SignatureErr error // 서명 검증 결과 (nil이면 신뢰하는 키로 서명됨)
+}
+
+// fetchTemplate Gist에서 템플릿과 매니페스트 조회
+// ref가 수정 이력 SHA이면 해당 시점, 버전 조건이면 조건에 맞는 가장 높은 배포 버전의 내용을 조회한다.
+func fetchTemplate(client *gist.GistClient, templateName, ref string) (*remoteTemplate, error) {
+	gistObj, err := client.FindGistByDescription(templateName)
+	if err != nil {
+		return nil, i18n.Errorf("템플릿 다운로드 실패: %w", err)
+	}
+
+	remote := &remoteTemplate{GistID: gistObj.GetID()}
+	contents, err := fetchContents(client, remote, ref)
+	if err != nil {
+		return nil, i18n.Errorf("템플릿 내용 조회 실패: %w", err)
+	}
+
+	if err := loadRemoteContents(remote, templateName, contents); err != nil {
+		return nil, err
+	}
+	return remote, nil
+}
+
+// loadRemoteContents Gist 내용으로 템플릿을 구성하고 해시와 서명 검증
+// 서명은 매니페스트만 대상으로 하므로, 매니페스트가 요청한 템플릿의 것이고
+// 모든 파일이 매니페스트에 기록된 SHA-256 해시와 일치해야 내용 전체가 보호된다.
+func loadRemoteContents(remote *remoteTemplate, templateName string, contents map[string]string) error {
+	manifest, files, err := gist.SplitManifest(contents)
+	if err != nil {
+		return clierr.Wrap(clierr.Integrity, err)
+	}
+	remote.Manifest = manifest
+	remote.Contents = contents
+
+	signed := contents[gist.SignatureFile] != ""
+	if manifest != nil && manifest.Name != templateName {
+		return clierr.New(clierr.Integrity, "템플릿 '%s' 검증 실패: 매니페스트가 다른 템플릿('%s')의 것입니다", templateName, manifest.Name)
+	}
+
+	// 템플릿 생성
+	remote.Template = models.NewTemplate(templateName, "")
+
+	// 각 파일을 템플릿에 추가
+	for filename, content := range files {
+		// 파일 구조 보존을 위해 경로를 키로 사용
+		remote.Template.AddFile(filename, content, filename)
+	}
+
+	// 매니페스트에 기록된 해시로 내용 검증 (서명된 매니페스트는 SHA-256 해시만 허용)
+	// 암호화된 템플릿은 암호문 해시가 기록되어 있으므로 복호화하기 전에 검증한다.
+	if manifest != nil {
+		if err := manifest.Verify(remote.Template, signed); err != nil {
+			return clierr.New(clierr.Integrity, "템플릿 '%s' 검증 실패: %w", templateName, err)
 		}
+	}
 
-		// 로컬에 저장
-		if err := filesystem.SaveLocalTemplate(template, nil); err != nil {
-			fmt.Printf("템플릿 저장 실패: %v\n", err)
-			return
+	if manifest != nil && manifest.Encryption != nil {
+		if err := decryptFiles(files, manifest.Encryption); err != nil {
+			return clierr.New(clierr.Integrity, "템플릿 '%s' 복호화 실패: %w", templateName, err)
 		}
+		for filename, content := range files {
+			remote.Template.AddFile(filename, content, filename)
+		}
+	}
 
-		fmt.Printf("템플릿 '%s'이(가) 성공적으로 다운로드되었습니다.\n", templateName)
-	},
+	// 매니페스트 서명 검증
+	if manifest == nil {
+		remote.SignatureErr = signing.ErrUnsigned
+	} else {
+		_, remote.SignatureErr = signing.Verify([]byte(contents[gist.ManifestFile]), contents[gist.SignatureFile])
+	}
+
+	return nil
+}
+
+// fetchContents 참조에 해당하는 시점의 Gist 내용 조회
+// 조회한 수정 이력과 배포 버전을 remote에 기록한다.
+func fetchContents(client *gist.GistClient, remote *remoteTemplate, ref string) (map[string]string, error) {
+	gistID := remote.GistID
+
+	if ref == "" {
+		revision, err := client.LatestRevision(gistID)
+		if err != nil {
+			return nil, err
+		}
+		remote.Revision = revision
+		return client.GetRevisionContent(gistID, revision)
+	}
+
+	if models.IsRevision(ref) {
+		revision, err := client.ResolveRevision(gistID, ref)
+		if err != nil {
+			return nil, err
+		}
+		remote.Revision = revision
+		return client.GetRevisionContent(gistID, revision)
+	}
+
+	// 버전 조건
+	if _, err := models.ParseConstraint(ref); err != nil {
+		return nil, clierr.Wrap(clierr.Validation, err)
+	}
+	latest, err := client.GetGistContent(gistID)
+	if err != nil {
+		return nil, err
+	}
+	manifest, _, err := gist.SplitManifest(latest)
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil || len(manifest.Releases) == 0 {
+		return nil, clierr.New(clierr.NotFound, "배포된 버전이 없습니다. 'upload --bump'로 버전을 배포하세요")
+	}
+	release, ok := manifest.ResolveRelease(ref)
+	if !ok {
+		return nil, clierr.New(clierr.NotFound, "'%s' 조건에 맞는 버전이 없습니다", ref)
+	}
+	remote.Revision = release.Revision
+	remote.Version = release.Version
+	return client.GetRevisionContent(gistID, release.Revision)
+}
+
+// installTemplate 충돌을 확인한 뒤 템플릿을 로컬에 저장
+// --force면 확인 없이 덮어쓰고, --merge면 기존 로컬 파일은 유지한 채 새 파일만 추가한다.
+// 사용자가 취소하면 false를 반환한다.
+func installTemplate(cmd *cobra.Command, template *models.Template, version *models.TemplateVersion) (bool, error) {
+	conflicts, err := filesystem.CheckConflicts(template)
+	if err != nil {
+		return false, err
+	}
+
+	keep, ok, err := resolveConflicts(cmd, conflicts,
+		"다음 파일이 이미 존재합니다 (덮어쓰면 .bak으로 백업됩니다):\n", "이미 존재하는 파일이 있어 설치를 중단합니다: %s")
+	if err != nil || !ok {
+		return false, err
+	}
+	for _, name := range keep {
+		template.RemoveFile(name)
+	}
+
+	if err := filesystem.SaveLocalTemplate(template, version); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// resolveConflicts --force, --merge 옵션과 충돌 처리 방식 설정에 따라 충돌 파일 처리 결정
+// 덮어쓰지 않고 유지할 파일 목록을 반환한다. 사용자가 취소하면 ok로 false를 반환한다.
+// header는 덮어쓸지 물을 때 충돌 파일 목록 앞에, abort는 abort 설정일 때 오류에 쓰는 문장이다.
+func resolveConflicts(cmd *cobra.Command, conflicts []string, header, abort string) (keep []string, ok bool, err error) {
+	force, _ := cmd.Flags().GetBool("force")
+	merge, _ := cmd.Flags().GetBool("merge")
+
+	// 옵션을 지정하지 않으면 설정의 충돌 처리 방식을 따름
+	policy := config.GetConflictPolicy()
+	if !force && !merge {
+		force = policy == config.ConflictOverwrite
+		merge = policy == config.ConflictMerge
+	}
+
+	if len(conflicts) == 0 || force {
+		return nil, true, nil
+	}
+
+	sort.Strings(conflicts)
+	if !merge && policy == config.ConflictAbort {
+		return nil, false, clierr.New(clierr.Conflict, abort, strings.Join(conflicts, ", "))
+	}
+	if merge {
+		for _, name := range conflicts {
+			output.Printf("유지: %s\n", name)
+		}
+		return conflicts, true, nil
+	}
+
+	output.Printf(header)
+	for _, name := range conflicts {
+		output.Printf("- %s\n", name)
+	}
+	overwrite, err := prompt.Confirm(i18n.T("덮어쓰시겠습니까?"), "--force", "--merge")
+	if err != nil || !overwrite {
+		return nil, false, err
+	}
+	return nil, true, nil
 }
 
 var uploadCmd = &cobra.Command{
 	Use:   "upload [name]",
 	Short: "로컬 템플릿 업로드",
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if len(args) != 1 {
-			fmt.Println("템플릿 이름을 지정해주세요")
-			return
-		}
-		templateName := args[0]
+	RunE: func(cmd *cobra.Command, args []string) error {
+		templateName := config.QualifyTemplateName(args[0])
 
 		client, err := gist.NewGistClient()
 		if err != nil {
-			fmt.Printf("Gist 클라이언트 생성 실패: %v\n", err)
-			return
+			return fail(clierr.Auth, i18n.Errorf("Gist 클라이언트 생성 실패: %w", err))
 		}
 
+		bump, _ := cmd.Flags().GetString("bump")
+		encrypt, _ := cmd.Flags().GetBool("encrypt")
+
 		// 로컬 템플릿 로드
-		localTemplate, _, err := filesystem.LoadLocalTemplate()
+		localTemplate, localVersion, skipped, err := filesystem.LoadLocalTemplate()
 		if err != nil {
-			fmt.Printf("로컬 템플릿 로드 실패: %v\n", err)
-			return
+			return fail(clierr.IO, i18n.Errorf("로컬 템플릿 로드 실패: %w", err))
+		}
+
+		// 업로드에서 제외되는 파일 안내
+		showIgnored, _ := cmd.Flags().GetBool("show-ignored")
+		for _, file := range skipped {
+			if file.Ignored || file.Default {
+				if showIgnored {
+					output.Printf("무시됨: '%s' (%s)\n", file.Path, file.Reason)
+				}
+				continue
+			}
+			output.Warnf("경고: '%s' 파일은 업로드되지 않습니다 (%s)\n", file.Path, file.Reason)
+		}
+
+		// 비밀 정보가 포함된 규칙은 업로드하지 않음
+		scanner, err := secrets.NewScanner(config.GetSecretPatterns())
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+		if findings := scanner.ScanTemplate(localTemplate); len(findings) > 0 {
+			allowSecrets, _ := cmd.Flags().GetBool("allow-secrets")
+			if allowSecrets {
+				output.Warnf("경고: 비밀 정보로 의심되는 내용이 포함되어 있습니다:\n")
+			} else {
+				output.Warnf("비밀 정보로 의심되는 내용이 있어 업로드를 중단합니다:\n")
+			}
+			for _, finding := range findings {
+				output.Warnf("  %s\n", finding)
+			}
+			if !allowSecrets {
+				return fail(clierr.Validation, i18n.NewError("내용을 확인한 후에도 업로드하려면 --allow-secrets 옵션을 사용하세요."))
+			}
+		}
+
+		// 템플릿 업로드
+		files := make(map[string]string)
+		for _, rule := range localTemplate.Files {
+			files[rule.Name] = rule.Content
 		}
 
 		// Gist에서 기존 템플릿 확인
-		gist, err := client.FindGistByDescription(templateName)
+		manifest := models.NewTemplateVersion(templateName, "v0.0.0")
+		result := uploadResult{Name: templateName, Changed: []string{}}
+		gistObj, err := client.FindGistByDescription(templateName)
+		if err != nil && clierr.CodeOf(err) != clierr.NotFound {
+			return fail(clierr.Network, err)
+		}
 		if err == nil {
 			// 기존 템플릿이 있는 경우 버전 비교
-			contents, err := client.GetGistContent(gist.GetID())
+			contents, err := client.GetGistContent(gistObj.GetID())
 			if err != nil {
-				fmt.Printf("템플릿 내용 조회 실패: %v\n", err)
-				return
+				return fail(clierr.Network, i18n.Errorf("템플릿 내용 조회 실패: %w", err))
 			}
 
-			needsUpdate := false
-			for filename, content := range contents {
-				localRule, exists := localTemplate.Files[filename]
-				if !exists || localRule.Content != content {
-					needsUpdate = true
-					fmt.Printf("업데이트 필요: %s\n", filename)
+			remoteManifest, remoteFiles, err := gist.SplitManifest(contents)
+			if err != nil {
+				return fail(clierr.Integrity, err)
+			}
+			if remoteManifest != nil {
+				manifest = remoteManifest
+			}
+
+			// 암호화된 템플릿은 매니페스트에 암호문 해시만 있으므로 복호화한 내용으로 비교
+			if manifest.Encryption != nil {
+				if err := decryptFiles(remoteFiles, manifest.Encryption); err != nil {
+					return fail(clierr.Integrity, i18n.Errorf("기존 템플릿 복호화 실패: %w", err))
 				}
+				if !encrypt {
+					output.Printf("기존 템플릿이 암호화되어 있어 암호화하여 업로드합니다.\n")
+					encrypt = true
+				}
+			}
+			remoteHashes := make(map[string]string)
+			for filename, content := range remoteFiles {
+				remoteHashes[filename] = models.HashContent(content)
 			}
 
-			if !needsUpdate {
-				fmt.Println("모든 파일이 최신 상태입니다.")
-				return
+			for filename, hash := range remoteHashes {
+				localInfo, exists := localVersion.Files[filename]
+				if !exists || localInfo.Hash != hash {
+					result.Changed = append(result.Changed, filename)
+				}
+			}
+			for filename := range files {
+				if _, exists := remoteHashes[filename]; !exists {
+					result.Changed = append(result.Changed, filename)
+				}
+			}
+			sort.Strings(result.Changed)
+			for _, filename := range result.Changed {
+				output.Printf("업데이트 필요: %s\n", filename)
+			}
+
+			if len(result.Changed) == 0 && bump == "" {
+				result.GistID = gistObj.GetID()
+				result.UpToDate = true
+				return printResult(result, func() {
+					i18n.Printf("모든 파일이 최신 상태입니다.\n")
+				})
 			}
 		}
 
-		// 템플릿 업로드
-		files := make(map[string]string)
-		for _, rule := range localTemplate.Files {
-			files[rule.Name] = rule.Content
+		// 배포할 버전 계산
+		var next models.SemVer
+		if bump != "" {
+			var current models.SemVer
+			if latest, ok := manifest.LatestRelease(); ok {
+				current, _ = models.ParseSemVer(latest.Version)
+			}
+			next, err = current.Bump(bump)
+			if err != nil {
+				return fail(clierr.Validation, err)
+			}
 		}
 
-		if gist == nil {
+		// 매니페스트 갱신
+		manifest.Name = templateName
+		manifest.Files = maps.Clone(localVersion.Files)
+		manifest.UpdatedAt = time.Now()
+		manifest.Encryption = nil
+		if encrypt {
+			key, err := newEncryptionKey()
+			if err != nil {
+				return fail(clierr.Internal, i18n.Errorf("암호화 키 생성 실패: %w", err))
+			}
+			if err := encryptFiles(files, manifest, key); err != nil {
+				return fail(clierr.Internal, i18n.Errorf("템플릿 암호화 실패: %w", err))
+			}
+			manifest.Encryption = key.Info()
+		}
+		signed, err := setManifest(files, manifest)
+		if err != nil {
+			return fail(clierr.Internal, err)
+		}
+		if !signed {
+			output.Warnf("경고: 서명 키가 없어 서명하지 않고 업로드합니다. 'cursorrules keys generate'로 키를 생성하세요.\n")
+		}
+
+		var gistID string
+		if gistObj == nil {
 			// 새 Gist 생성
-			_, err = client.CreateGist(templateName, files)
+			var created *github.Gist
+			created, err = client.CreateGist(templateName, files)
+			gistID = created.GetID()
 		} else {
-			// 기존 Gist 업데이트
-			if err := client.DeleteGist(gist.GetID()); err != nil {
-				fmt.Printf("기존 템플릿 삭제 실패: %v\n", err)
-				return
-			}
-			_, err = client.CreateGist(templateName, files)
+			// 기존 Gist 업데이트 (수정 이력 유지)
+			gistID = gistObj.GetID()
+			_, err = client.UpdateGist(gistID, files)
 		}
 
 		if err != nil {
-			fmt.Printf("템플릿 업로드 실패: %v\n", err)
-			return
+			return fail(clierr.Network, i18n.Errorf("템플릿 업로드 실패: %w", err))
 		}
 
-		fmt.Printf("템플릿 '%s'이(가) 성공적으로 업로드되었습니다.\n", templateName)
+		// 방금 올린 수정 이력을 배포 버전으로 기록
+		if bump != "" {
+			revision, err := client.LatestRevision(gistID)
+			if err != nil {
+				return fail(clierr.Network, i18n.Errorf("버전 배포 실패: %w", err))
+			}
+
+			manifest.AddRelease(next.String(), revision)
+			if _, err := setManifest(files, manifest); err != nil {
+				return fail(clierr.Internal, err)
+			}
+			if _, err := client.UpdateGist(gistID, files); err != nil {
+				return fail(clierr.Network, i18n.Errorf("버전 배포 실패: %w", err))
+			}
+
+			output.Printf("버전 %s이(가) 배포되었습니다. (수정 이력 %s)\n", next, shortRevision(revision))
+			result.Version = next.String()
+			result.Revision = revision
+		}
+
+		result.GistID = gistID
+		result.Signed = signed
+		result.Encrypted = encrypt
+		if gistObj == nil {
+			result.Changed = templatePaths(localTemplate)
+		}
+		return printResult(result, func() {
+			i18n.Printf("템플릿 '%s'이(가) 성공적으로 업로드되었습니다.\n", templateName)
+		})
 	},
 }
 
+// uploadResult upload 명령 결과
+type uploadResult struct {
+	Name      string   `json:"name"`
+	GistID    string   `json:"gist_id"`
+	Changed   []string `json:"changed"`            // 원격과 내용이 달라 갱신한 파일
+	UpToDate  bool     `json:"up_to_date"`         // 변경 사항이 없어 업로드하지 않음
+	Version   string   `json:"version,omitempty"`  // --bump로 배포한 버전
+	Revision  string   `json:"revision,omitempty"` // 배포한 버전의 수정 이력
+	Signed    bool     `json:"signed"`
+	Encrypted bool     `json:"encrypted"`
+}
+
+// setManifest 업로드할 파일 목록에 매니페스트와 서명 추가
+// 서명 키가 없으면 서명 없이 매니페스트만 추가하고 false를 반환한다.
+func setManifest(files map[string]string, manifest *models.TemplateVersion) (bool, error) {
+	data, err := manifest.ToJSONString()
+	if err != nil {
+		return false, err
+	}
+	files[gist.ManifestFile] = data
+	delete(files, gist.SignatureFile)
+
+	priv, err := signing.LoadPrivateKey(config.GetSigningKey())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, i18n.Errorf("서명 키 로드 실패: %w", err)
+	}
+
+	sig, err := signing.Sign([]byte(data), priv).Marshal()
+	if err != nil {
+		return false, err
+	}
+	files[gist.SignatureFile] = sig
+	return true, nil
+}
+
+// checkSignature 템플릿 서명 검증 결과 확인
+// 서명이 없거나 올바르지 않으면 설치를 거부하며, --allow-unsigned가 지정된 경우 경고만 출력한다.
+func checkSignature(cmd *cobra.Command, remote *remoteTemplate) error {
+	if remote.SignatureErr == nil {
+		return nil
+	}
+
+	allowUnsigned, _ := cmd.Flags().GetBool("allow-unsigned")
+	if allowUnsigned {
+		output.Warnf("경고: 템플릿 '%s' 서명 검증 실패: %v\n", remote.Template.Name, remote.SignatureErr)
+		return nil
+	}
+	return clierr.New(clierr.Integrity, "템플릿 '%s' 서명 검증 실패: %w (--allow-unsigned 옵션으로 무시할 수 있습니다)", remote.Template.Name, remote.SignatureErr)
+}
+
 var deleteCmd = &cobra.Command{
 	Use:   "delete [name]",
 	Short: "템플릿 삭제",
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		projectName := args[0]
+	RunE: func(cmd *cobra.Command, args []string) error {
+		projectName := config.QualifyTemplateName(args[0])
 		force, _ := cmd.Flags().GetBool("force")
 
 		client, err := gist.NewGistClient()
 		if err != nil {
-			fmt.Printf("Gist 클라이언트 생성 실패: %v\n", err)
-			return
+			return fail(clierr.Auth, i18n.Errorf("Gist 클라이언트 생성 실패: %w", err))
 		}
 
 		gist, err := client.FindGistByDescription(projectName)
 		if err != nil {
-			fmt.Printf("프로젝트 '%s'를 찾을 수 없습니다: %v\n", projectName, err)
-			return
+			return fail(clierr.NotFound, err)
 		}
 
 		if !force {
-			reader := bufio.NewReader(os.Stdin)
-			fmt.Printf("정말로 '%s' 템플릿을 삭제하시겠습니까? (y/N): ", projectName)
-			answer, _ := reader.ReadString('\n')
-			if strings.TrimSpace(strings.ToLower(answer)) != "y" {
-				fmt.Println("삭제가 취소되었습니다.")
-				return
+			confirmed, err := prompt.Confirm(i18n.Sprintf("정말로 '%s' 템플릿을 삭제하시겠습니까?", projectName), "--force")
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				return fail(clierr.Canceled, i18n.NewError("삭제가 취소되었습니다."))
 			}
 		}
 
 		if err := client.DeleteGist(gist.GetID()); err != nil {
-			fmt.Printf("템플릿 삭제 실패: %v\n", err)
-			return
+			return fail(clierr.Network, i18n.Errorf("템플릿 삭제 실패: %w", err))
+		}
+
+		result := deleteResult{Name: projectName, GistID: gist.GetID()}
+		return printResult(result, func() {
+			i18n.Printf("프로젝트 '%s'의 템플릿이 삭제되었습니다.\n", projectName)
+		})
+	},
+}
+
+// deleteResult delete 명령 결과
+type deleteResult struct {
+	Name   string `json:"name"`
+	GistID string `json:"gist_id"`
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "로컬 규칙을 다른 AI 어시스턴트 형식으로 내보내기",
+	Long:  fmt.Sprintf(exportLong, strings.Join(convert.Names(), ", ")),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		formatName, _ := cmd.Flags().GetString("format")
+		format, err := convert.Get(formatName)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+
+		localTemplate, _, _, err := filesystem.LoadLocalTemplate()
+		if err != nil {
+			return fail(clierr.IO, i18n.Errorf("로컬 템플릿 로드 실패: %w", err))
+		}
+
+		files, err := format.Export(localTemplate)
+		if err != nil {
+			return fail(clierr.Validation, i18n.Errorf("내보내기 실패: %w", err))
+		}
+
+		_, others := convert.RuleFiles(localTemplate)
+		sort.Strings(others)
+		for _, path := range others {
+			output.Warnf("경고: '%s' 파일은 %s 형식으로 내보낼 수 없습니다\n", path, format.Name())
+		}
+
+		if err := filesystem.SaveProjectFiles(files); err != nil {
+			return fail(clierr.IO, i18n.Errorf("파일 저장 실패: %w", err))
+		}
+
+		paths := make([]string, 0, len(files))
+		for path := range files {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		result := convertResult{Format: format.Name(), Files: paths, Skipped: others, Note: format.Lossy()}
+		return printResult(result, func() {
+			for _, path := range paths {
+				fmt.Printf("- %s\n", path)
+			}
+			i18n.Printf("참고: %s\n", result.Note)
+		})
+	},
+}
+
+// convertResult export, import 명령 결과
+type convertResult struct {
+	Format  string   `json:"format"`
+	Files   []string `json:"files"`             // 저장한 파일
+	Skipped []string `json:"skipped,omitempty"` // 변환하지 못한 파일
+	Note    string   `json:"note,omitempty"`    // 변환 시 손실되는 정보 안내
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "다른 AI 어시스턴트 형식의 지침을 로컬 규칙으로 가져오기",
+	Long:  fmt.Sprintf(importLong, strings.Join(convert.Names(), ", ")),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		formatName, _ := cmd.Flags().GetString("format")
+		format, err := convert.Get(formatName)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+
+		projectDir, err := filesystem.GetProjectDir()
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		template, err := format.Import(projectDir)
+		if err != nil {
+			return fail(clierr.IO, i18n.Errorf("가져오기 실패: %w", err))
+		}
+
+		if err := filesystem.SaveLocalTemplate(template, nil); err != nil {
+			return fail(clierr.IO, i18n.Errorf("템플릿 저장 실패: %w", err))
 		}
 
-		fmt.Printf("프로젝트 '%s'의 템플릿이 삭제되었습니다.\n", projectName)
+		result := convertResult{Format: format.Name(), Files: templatePaths(template)}
+		return printResult(result, func() {
+			i18n.Printf("%s 형식에서 %d개의 규칙을 가져왔습니다.\n", format.Name(), len(template.Files))
+		})
 	},
 }
 
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "레거시 .cursorrules 파일을 .cursor/rules 규칙으로 분리",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		force, _ := cmd.Flags().GetBool("force")
+		keep, _ := cmd.Flags().GetBool("keep")
+
+		projectDir, err := filesystem.GetProjectDir()
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		legacyPath := filepath.Join(projectDir, filesystem.LegacyRulesFile)
+		content, err := os.ReadFile(legacyPath)
+		if err != nil {
+			return fail(clierr.NotFound, i18n.Errorf("%s 파일을 읽을 수 없습니다: %w", filesystem.LegacyRulesFile, err))
+		}
+
+		rules := convert.MigrateLegacy(string(content))
+		if len(rules) == 0 {
+			return printResult(migrateResult{Files: []string{}}, func() {
+				i18n.Printf("변환할 내용이 없습니다.\n")
+			})
+		}
+
+		// 미리보기
+		output.Printf("%s 파일을 %d개의 규칙으로 분리합니다:\n", filesystem.LegacyRulesFile, len(rules))
+		template := models.NewTemplate("migrate", "")
+		for _, rule := range rules {
+			output.Printf("- %s\n", rule.Path)
+			output.Printf("    description: %s\n", rule.Meta.Description)
+			if len(rule.Meta.Globs) > 0 {
+				output.Printf("    globs: %s (추천)\n", strings.Join(rule.Meta.Globs, ","))
+			} else {
+				output.Println("    alwaysApply: true")
+			}
+			template.AddFile(rule.Path, models.FormatRuleContent(rule.Meta, rule.Body), rule.Path)
+		}
+
+		if !force {
+			confirmed, err := prompt.Confirm(i18n.T("위 내용으로 규칙을 생성하시겠습니까?"), "--force")
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				return fail(clierr.Canceled, i18n.NewError("변환이 취소되었습니다."))
+			}
+		}
+
+		if err := filesystem.SaveLocalTemplate(template, nil); err != nil {
+			return fail(clierr.IO, i18n.Errorf("템플릿 저장 실패: %w", err))
+		}
+
+		// 규칙이 중복 업로드되지 않도록 원본은 백업으로 옮김
+		result := migrateResult{Files: templatePaths(template)}
+		if !keep {
+			if err := os.Rename(legacyPath, legacyPath+".bak"); err != nil {
+				return fail(clierr.IO, i18n.Errorf("원본 백업 실패: %w", err))
+			}
+			output.Printf("원본 파일은 %s.bak으로 옮겼습니다.\n", filesystem.LegacyRulesFile)
+			result.Backup = filesystem.LegacyRulesFile + ".bak"
+		}
+
+		return printResult(result, func() {
+			i18n.Printf("%d개의 규칙이 생성되었습니다.\n", len(rules))
+		})
+	},
+}
+
+// migrateResult migrate 명령 결과
+type migrateResult struct {
+	Files  []string `json:"files"`            // 생성한 규칙 파일
+	Backup string   `json:"backup,omitempty"` // 원본을 옮긴 백업 파일
+}
+
+var packCmd = &cobra.Command{
+	Use:   "pack [name][@revision]",
+	Short: "템플릿을 오프라인 번들 파일로 저장",
+	Long: "템플릿을 .tar.gz, .zip 또는 .json 번들 파일로 저장합니다. --local 옵션을 사용하면 Gist 대신 로컬 규칙을 저장합니다.\n" +
+		"압축 번들에는 Gist의 매니페스트와 서명이 그대로 포함되며(로컬 규칙은 서명 키로 새로 서명), .json 번들에는 서명이 포함되지 않습니다.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		templateName, ref := parseTemplateRef(args[0])
+		path, _ := cmd.Flags().GetString("file")
+		local, _ := cmd.Flags().GetBool("local")
+
+		// 네임스페이스가 있는 이름(team/name)도 현재 디렉토리에 저장되도록 '/'를 '-'로 바꿈
+		if path == "" {
+			path = strings.NewReplacer("/", "-", `\`, "-").Replace(templateName) + ".tar.gz"
+		}
+		format, err := bundle.DetectFormat(path)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+
+		var template *models.Template
+		var files map[string]string
+		if local {
+			localTemplate, _, _, err := filesystem.LoadLocalTemplate()
+			if err != nil {
+				return fail(clierr.IO, i18n.Errorf("로컬 템플릿 로드 실패: %w", err))
+			}
+			template = localTemplate
+			template.Name = templateName
+
+			files = make(map[string]string, len(template.Files))
+			for filePath, rule := range template.Files {
+				files[filePath] = rule.Content
+			}
+			if format != bundle.FormatJSON {
+				signed, err := setManifest(files, bundle.NewManifest(template))
+				if err != nil {
+					return fail(clierr.Internal, err)
+				}
+				if !signed {
+					output.Warnf("경고: 서명 키가 없어 서명하지 않고 저장합니다. 'cursorrules keys generate'로 키를 생성하세요.\n")
+				}
+			}
+		} else {
+			client, err := gist.NewGistClient()
+			if err != nil {
+				return fail(clierr.Auth, i18n.Errorf("Gist 클라이언트 생성 실패: %w", err))
+			}
+			remote, err := fetchTemplate(client, templateName, ref)
+			if err != nil {
+				return fail(clierr.Internal, err)
+			}
+			template = remote.Template
+
+			// 압축 번들은 서명을 유지하도록 Gist 원본을, .json 번들은 복호화한 내용을 저장
+			files = remote.Contents
+			if format == bundle.FormatJSON {
+				files = make(map[string]string, len(template.Files))
+				for filePath, rule := range template.Files {
+					files[filePath] = rule.Content
+				}
+			} else if remote.Manifest == nil {
+				// 매니페스트가 없는 이전 템플릿은 서명하지 않은 매니페스트만 추가
+				manifestData, err := bundle.NewManifest(template).ToJSONString()
+				if err != nil {
+					return fail(clierr.Internal, err)
+				}
+				files[gist.ManifestFile] = manifestData
+			}
+		}
+
+		contents := bundle.Contents{
+			Name:      templateName,
+			Files:     make(map[string]string, len(files)),
+			Manifest:  files[gist.ManifestFile],
+			Signature: files[gist.SignatureFile],
+		}
+		for filePath, content := range files {
+			if filePath != gist.ManifestFile && filePath != gist.SignatureFile {
+				contents.Files[filePath] = content
+			}
+		}
+
+		if err := bundle.Pack(contents, path); err != nil {
+			return fail(clierr.IO, i18n.Errorf("번들 저장 실패: %w", err))
+		}
+
+		result := packResult{Name: templateName, Bundle: path, Files: templatePaths(template)}
+		return printResult(result, func() {
+			i18n.Printf("템플릿 '%s'이(가) %s에 저장되었습니다. (%d개 파일)\n", templateName, path, len(template.Files))
+		})
+	},
+}
+
+// packResult pack 명령 결과
+type packResult struct {
+	Name   string   `json:"name"`
+	Bundle string   `json:"bundle"` // 저장한 번들 파일 경로
+	Files  []string `json:"files"`
+}
+
+var unpackCmd = &cobra.Command{
+	Use:   "unpack [file]",
+	Short: "번들 파일에서 템플릿 설치",
+	Long: "번들 파일의 템플릿을 설치합니다. Gist에서 내려받을 때와 같이 매니페스트의 해시와 서명을 검증하며,\n" +
+		"서명이 없는 번들(.json 번들 포함)은 --allow-unsigned 옵션을 지정해야 설치할 수 있습니다.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		contents, err := bundle.Unpack(args[0])
+		if err != nil {
+			return fail(clierr.IO, i18n.Errorf("번들 읽기 실패: %w", err))
+		}
+
+		files := make(map[string]string, len(contents.Files)+2)
+		for filePath, content := range contents.Files {
+			files[filePath] = content
+		}
+		if contents.Manifest != "" {
+			files[gist.ManifestFile] = contents.Manifest
+		}
+		if contents.Signature != "" {
+			files[gist.SignatureFile] = contents.Signature
+		}
+
+		remote := &remoteTemplate{}
+		if err := loadRemoteContents(remote, contents.Name, files); err != nil {
+			return fail(clierr.Integrity, err)
+		}
+		if err := checkSignature(cmd, remote); err != nil {
+			return fail(clierr.Integrity, err)
+		}
+		template := remote.Template
+
+		installed, err := installTemplate(cmd, template, remote.Manifest)
+		if err != nil {
+			return fail(clierr.IO, i18n.Errorf("템플릿 저장 실패: %w", err))
+		}
+		if !installed {
+			return fail(clierr.Canceled, i18n.NewError("설치가 취소되었습니다."))
+		}
+
+		result := installResult{Name: template.Name, Bundle: args[0], Files: templatePaths(template)}
+		return printResult(result, func() {
+			i18n.Printf("번들 '%s'의 템플릿이 설치되었습니다. (%d개 파일)\n", args[0], len(template.Files))
+		})
+	},
+}
+
+var logCmd = &cobra.Command{
+	Use:   "log [name]",
+	Short: "템플릿 수정 이력 출력",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		templateName := config.QualifyTemplateName(args[0])
+
+		client, err := gist.NewGistClient()
+		if err != nil {
+			return fail(clierr.Auth, i18n.Errorf("Gist 클라이언트 생성 실패: %w", err))
+		}
+
+		gistObj, err := client.FindGistByDescription(templateName)
+		if err != nil {
+			return fail(clierr.Network, i18n.Errorf("템플릿 조회 실패: %w", err))
+		}
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		revisions, err := client.ListRevisions(gistObj.GetID(), limit)
+		if err != nil {
+			return fail(clierr.Network, i18n.Errorf("수정 이력 조회 실패: %w", err))
+		}
+
+		return printResult(revisions, func() {
+			for _, revision := range revisions {
+				fmt.Printf("%s  %s  (+%d -%d)\n",
+					shortRevision(revision.Version),
+					revision.CommittedAt.Local().Format("2006-01-02 15:04:05"),
+					revision.Additions, revision.Deletions)
+				for _, file := range revision.Files {
+					fmt.Printf("    %s %s\n", fileStatusMark(file.Status), file.Filename)
+				}
+			}
+		})
+	},
+}
+
+// fileStatusMark 파일 변경 상태 표시 문자
+func fileStatusMark(status string) string {
+	switch status {
+	case gist.FileAdded:
+		return "A"
+	case gist.FileDeleted:
+		return "D"
+	default:
+		return "M"
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(authCmd)
 	rootCmd.AddCommand(listCmd)
 	rootCmd.AddCommand(downloadCmd)
 	rootCmd.AddCommand(uploadCmd)
 	rootCmd.AddCommand(deleteCmd)
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(importCmd)
+	rootCmd.AddCommand(migrateCmd)
+	rootCmd.AddCommand(packCmd)
+	rootCmd.AddCommand(unpackCmd)
+	rootCmd.AddCommand(logCmd)
+	rootCmd.AddCommand(statusCmd)
+	rootCmd.AddCommand(diffCmd)
+	rootCmd.AddCommand(installCmd)
+	rootCmd.AddCommand(updateCmd)
+	rootCmd.AddCommand(keysCmd)
+	rootCmd.AddCommand(profileCmd)
+	rootCmd.AddCommand(configCmd)
 
+	rootCmd.PersistentFlags().String("output", "", "출력 형식 (table, json, yaml; 기본값: output 설정)")
+	rootCmd.PersistentFlags().String("profile", "", "사용할 프로필 (기본값: CURSORRULES_PROFILE 또는 'profile use'로 지정한 프로필)")
+	rootCmd.PersistentFlags().BoolP("yes", "y", false, "확인 질문에 묻지 않고 yes로 답함")
+	rootCmd.PersistentFlags().Bool("no-input", false, "입력을 기다리지 않음 (입력이 필요하면 오류)")
+
+	listCmd.Flags().Bool("fast", false, "매니페스트를 조회하지 않고 출력 (설명, 배포 버전, 암호화 여부 생략)")
 	downloadCmd.Flags().BoolP("force", "f", false, "강제로 덮어쓰기")
 	downloadCmd.Flags().BoolP("merge", "m", false, "로컬 파일과 병합")
+	downloadCmd.Flags().Bool("allow-unsigned", false, "서명이 없거나 올바르지 않은 템플릿도 설치")
+	uploadCmd.Flags().Bool("show-ignored", false, ".cursorrulesignore 또는 기본 제외 패턴에 의해 제외된 파일 출력")
+	statusCmd.Flags().Bool("show-ignored", false, ".cursorrulesignore에 의해 제외된 파일 출력")
+	diffCmd.Flags().Bool("show-ignored", false, ".cursorrulesignore에 의해 제외된 파일 출력")
+	uploadCmd.Flags().Bool("allow-secrets", false, "비밀 정보로 의심되는 내용이 있어도 업로드")
+	uploadCmd.Flags().Bool("encrypt", false, "파일 내용을 암호화하여 업로드")
+	uploadCmd.Flags().String("bump", "", "업로드한 내용을 새 버전으로 배포 (major, minor, patch)")
 	deleteCmd.Flags().BoolP("force", "f", false, "확인 없이 강제 삭제")
+	exportCmd.Flags().String("format", "", fmt.Sprintf(exportFormatUsage, strings.Join(convert.Names(), ", ")))
+	exportCmd.MarkFlagRequired("format")
+	importCmd.Flags().String("format", "", fmt.Sprintf(importFormatUsage, strings.Join(convert.Names(), ", ")))
+	importCmd.MarkFlagRequired("format")
+	migrateCmd.Flags().BoolP("force", "f", false, "확인 없이 변환")
+	migrateCmd.Flags().Bool("keep", false, "변환 후 원본 .cursorrules 파일 유지")
+	packCmd.Flags().StringP("file", "o", "", "번들 파일 경로 (.tar.gz, .zip, .json, 기본값: <이름>.tar.gz)")
+	packCmd.Flags().Bool("local", false, "Gist 대신 로컬 규칙을 번들로 저장")
+	unpackCmd.Flags().BoolP("force", "f", false, "강제로 덮어쓰기")
+	unpackCmd.Flags().BoolP("merge", "m", false, "로컬 파일과 병합")
+	unpackCmd.Flags().Bool("allow-unsigned", false, "서명이 없거나 올바르지 않은 템플릿도 설치")
+	logCmd.Flags().IntP("limit", "n", 20, "출력할 최근 수정 이력 수 (0이면 전체)")
+	updateCmd.Flags().Bool("dry-run", false, "변경 내용만 보여주고 갱신하지 않음")
+	updateCmd.Flags().BoolP("force", "f", false, "로컬에서 수정한 파일도 확인 없이 덮어쓰기")
+	updateCmd.Flags().BoolP("merge", "m", false, "로컬에서 수정한 파일은 유지")
+	updateCmd.Flags().Bool("allow-unsigned", false, "서명이 없거나 올바르지 않은 템플릿도 설치")
+	installCmd.Flags().BoolP("force", "f", false, "로컬에서 수정한 파일도 확인 없이 덮어쓰기")
+	installCmd.Flags().BoolP("merge", "m", false, "로컬에서 수정한 파일은 유지")
+	installCmd.Flags().Bool("allow-unsigned", false, "서명이 없거나 올바르지 않은 템플릿도 설치")
 }
 
 func main() {
+	setupLanguage()
+	// 명령 실행 오류는 모두 코드가 붙어 있으므로, 코드가 없는 오류는 cobra의 인자/옵션 오류다
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+		err = clierr.Default(clierr.Validation, err)
+		output.Error(err)
+		os.Exit(clierr.ExitCode(err))
 	}
-} 
\ No newline at end of file
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RuleMeta .mdc 규칙 파일의 frontmatter 정보
+type RuleMeta struct {
+	Description string   `json:"description"` // 규칙 설명
+	Globs       []string `json:"globs"`       // 규칙이 적용될 파일 패턴
+	AlwaysApply bool     `json:"alwaysApply"` // 항상 적용 여부
+}
+
+// ParseRuleContent 규칙 파일 내용을 frontmatter와 본문으로 분리
+// frontmatter가 없으면 빈 RuleMeta와 원본 내용을 그대로 반환한다.
+func ParseRuleContent(content string) (RuleMeta, string) {
+	var meta RuleMeta
+
+	fields, body, ok := ParseFrontmatter(content)
+	if !ok {
+		return meta, content
+	}
+
+	meta.Description = unquote(fields["description"])
+	meta.Globs = ParseGlobs(fields["globs"])
+	meta.AlwaysApply = fields["alwaysApply"] == "true"
+
+	return meta, body
+}
+
+// ParseFrontmatter 내용 앞부분의 "---"로 감싼 frontmatter를 key/value로 파싱
+// frontmatter가 없으면 ok가 false이다.
+func ParseFrontmatter(content string) (map[string]string, string, bool) {
+	normalized := strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(normalized, "---\n") {
+		return nil, content, false
+	}
+
+	rest := normalized[len("---\n"):]
+	var header, body string
+	if strings.HasPrefix(rest, "---") {
+		// 빈 frontmatter
+		body = rest[len("---"):]
+	} else {
+		end := strings.Index(rest, "\n---")
+		if end < 0 {
+			return nil, content, false
+		}
+		header = rest[:end]
+		body = rest[end+len("\n---"):]
+	}
+	body = strings.TrimPrefix(body, "\n")
+
+	fields := make(map[string]string)
+	for _, line := range strings.Split(header, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		fields[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return fields, body, true
+}
+
+// FormatRuleContent frontmatter와 본문을 .mdc 규칙 파일 내용으로 결합
+func FormatRuleContent(meta RuleMeta, body string) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "description: %s\n", meta.Description)
+	fmt.Fprintf(&b, "globs: %s\n", strings.Join(meta.Globs, ","))
+	fmt.Fprintf(&b, "alwaysApply: %t\n", meta.AlwaysApply)
+	b.WriteString("---\n")
+	b.WriteString(body)
+	return b.String()
+}
+
+// ParseGlobs 쉼표로 구분된 glob 목록 파싱
+// "a,b", "[a, b]", "\"a\", \"b\"" 형식을 모두 허용한다.
+func ParseGlobs(value string) []string {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(value, "[")
+	value = strings.TrimSuffix(value, "]")
+
+	var globs []string
+	for _, glob := range strings.Split(value, ",") {
+		glob = unquote(strings.TrimSpace(glob))
+		if glob != "" {
+			globs = append(globs, glob)
+		}
+	}
+	return globs
+}
+
+// unquote 따옴표로 감싼 값에서 따옴표 제거
+func unquote(value string) string {
+	if len(value) >= 2 {
+		first, last := value[0], value[len(value)-1]
+		if (first == '"' || first == '\'') && first == last {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
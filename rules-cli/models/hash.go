@@ -0,0 +1,24 @@
+package models
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+const (
+	// hashPrefix 해시 알고리즘 표시
+	hashPrefix = "sha256:"
+)
+
+// HashContent 파일 내용의 해시 (로컬과 원격에서 공통으로 사용)
+// 운영체제에 따라 달라지는 줄바꿈(CRLF)은 LF로 통일한 뒤 SHA-256을 계산한다.
+func HashContent(content string) string {
+	normalized := strings.ReplaceAll(content, "\r\n", "\n")
+	return fmt.Sprintf("%s%x", hashPrefix, sha256.Sum256([]byte(normalized)))
+}
+
+// IsContentHash HashContent로 계산된 해시인지 확인 (이전 버전의 MD5 해시 구분용)
+func IsContentHash(hash string) bool {
+	return strings.HasPrefix(hash, hashPrefix)
+}
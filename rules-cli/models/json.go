@@ -2,9 +2,10 @@ package models
 
 import (
 	"encoding/json"
-	"fmt"
 	"io"
 	"os"
+
+	"github.com/tinysolver/rules-cli/i18n"
 )
 
 // ToJSON 템플릿을 JSON으로 변환
@@ -16,7 +17,7 @@ func (t *Template) ToJSON() ([]byte, error) {
 func FromJSON(data []byte) (*Template, error) {
 	var template Template
 	if err := json.Unmarshal(data, &template); err != nil {
-		return nil, fmt.Errorf("JSON 변환 실패: %v", err)
+		return nil, i18n.Errorf("JSON 변환 실패: %w", err)
 	}
 	return &template, nil
 }
@@ -29,7 +30,7 @@ func (t *Template) SaveToFile(path string) error {
 	}
 
 	if err := os.WriteFile(path, data, 0644); err != nil {
-		return fmt.Errorf("파일 저장 실패: %v", err)
+		return i18n.Errorf("파일 저장 실패: %w", err)
 	}
 
 	return nil
@@ -39,7 +40,7 @@ func (t *Template) SaveToFile(path string) error {
 func LoadFromFile(path string) (*Template, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
-		return nil, fmt.Errorf("파일 읽기 실패: %v", err)
+		return nil, i18n.Errorf("파일 읽기 실패: %w", err)
 	}
 
 	return FromJSON(data)
@@ -49,7 +50,7 @@ func LoadFromFile(path string) (*Template, error) {
 func LoadFromReader(r io.Reader) (*Template, error) {
 	data, err := io.ReadAll(r)
 	if err != nil {
-		return nil, fmt.Errorf("데이터 읽기 실패: %v", err)
+		return nil, i18n.Errorf("데이터 읽기 실패: %w", err)
 	}
 
 	return FromJSON(data)
@@ -59,7 +60,7 @@ func LoadFromReader(r io.Reader) (*Template, error) {
 func (t *Template) FromJSON(data []byte) error {
 	var temp Template
 	if err := json.Unmarshal(data, &temp); err != nil {
-		return fmt.Errorf("JSON 파싱 실패: %v", err)
+		return i18n.Errorf("JSON 파싱 실패: %w", err)
 	}
 	*t = temp
 	return nil
@@ -0,0 +1,249 @@
+package models
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+// SemVer 시맨틱 버전 (major.minor.patch[-prerelease])
+type SemVer struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease string
+}
+
+// ParseSemVer 버전 문자열 파싱 ("v" 접두사 허용)
+func ParseSemVer(s string) (SemVer, error) {
+	var v SemVer
+
+	core := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	core, v.Prerelease, _ = strings.Cut(core, "-")
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return v, i18n.Errorf("올바른 버전 형식이 아닙니다: %s (예: v1.2.3)", s)
+	}
+
+	nums := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return v, i18n.Errorf("올바른 버전 형식이 아닙니다: %s (예: v1.2.3)", s)
+		}
+		nums[i] = n
+	}
+	v.Major, v.Minor, v.Patch = nums[0], nums[1], nums[2]
+
+	return v, nil
+}
+
+// String "v1.2.3" 형식 문자열
+func (v SemVer) String() string {
+	s := fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// Compare 버전 비교 (v < o이면 -1, 같으면 0, 크면 1)
+func (v SemVer) Compare(o SemVer) int {
+	for _, d := range []int{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+
+	// 정식 버전이 같은 번호의 사전 배포 버전보다 높다
+	switch {
+	case v.Prerelease == o.Prerelease:
+		return 0
+	case v.Prerelease == "":
+		return 1
+	case o.Prerelease == "":
+		return -1
+	}
+	return comparePrerelease(v.Prerelease, o.Prerelease)
+}
+
+// comparePrerelease 사전 배포 식별자 비교
+// 점으로 나눈 식별자를 앞에서부터 비교하며, 숫자 식별자는 숫자로 비교하고 문자 식별자보다 낮다.
+// 앞부분이 모두 같으면 식별자가 많은 쪽이 높다 (예: rc.2 < rc.10, rc < rc.1).
+func comparePrerelease(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				return cmp.Compare(an, bn)
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		case as[i] != bs[i]:
+			return strings.Compare(as[i], bs[i])
+		}
+	}
+	return cmp.Compare(len(as), len(bs))
+}
+
+// Bump major, minor, patch 중 하나를 올린 버전
+// 사전 배포 버전은 해당 단계의 정식 버전이 아직 배포되지 않았으므로 번호를 올리지 않고 정식 버전으로 만든다
+// (예: v1.2.3-rc.1의 patch는 v1.2.3, v2.0.0-rc.1의 major는 v2.0.0).
+func (v SemVer) Bump(part string) (SemVer, error) {
+	pre := v.Prerelease != ""
+	switch part {
+	case "major":
+		if pre && v.Minor == 0 && v.Patch == 0 {
+			return SemVer{Major: v.Major}, nil
+		}
+		return SemVer{Major: v.Major + 1}, nil
+	case "minor":
+		if pre && v.Patch == 0 {
+			return SemVer{Major: v.Major, Minor: v.Minor}, nil
+		}
+		return SemVer{Major: v.Major, Minor: v.Minor + 1}, nil
+	case "patch":
+		if pre {
+			return SemVer{Major: v.Major, Minor: v.Minor, Patch: v.Patch}, nil
+		}
+		return SemVer{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}, nil
+	}
+	return v, i18n.Errorf("올바른 버전 단계가 아닙니다: %s (major, minor, patch)", part)
+}
+
+// Constraint 버전 범위 조건
+type Constraint struct {
+	raw   string
+	check func(SemVer) bool
+}
+
+// ParseConstraint 버전 범위 조건 파싱
+// 지원 형식: "^1.2", "~1.2.3", ">=1.0.0", ">1.0.0", "<=2.0.0", "<2.0.0",
+// "1.2.3"(정확히 일치), "1.2"/"1.2.x"(1.2.*), "1"/"1.x", "*", "latest"
+func ParseConstraint(s string) (Constraint, error) {
+	raw := strings.TrimSpace(s)
+	c := Constraint{raw: raw}
+
+	if raw == "" || raw == "*" || raw == "latest" {
+		c.check = func(v SemVer) bool { return v.Prerelease == "" }
+		return c, nil
+	}
+
+	op := ""
+	for _, prefix := range []string{">=", "<=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(raw, prefix) {
+			op = prefix
+			break
+		}
+	}
+
+	base, parts, err := parsePartial(strings.TrimPrefix(raw, op))
+	if err != nil {
+		return c, i18n.Errorf("올바른 버전 조건이 아닙니다: %s", s)
+	}
+
+	// 조건에 사전 배포 버전을 명시하지 않으면 사전 배포 버전은 제외
+	stable := func(v SemVer) bool { return v.Prerelease == "" || base.Prerelease != "" }
+
+	switch op {
+	case ">=":
+		c.check = func(v SemVer) bool { return stable(v) && v.Compare(base) >= 0 }
+	case ">":
+		c.check = func(v SemVer) bool { return stable(v) && v.Compare(base) > 0 }
+	case "<=":
+		c.check = func(v SemVer) bool { return stable(v) && v.Compare(base) <= 0 }
+	case "<":
+		c.check = func(v SemVer) bool { return stable(v) && v.Compare(base) < 0 }
+	case "^":
+		// 왼쪽에서 처음으로 0이 아닌 자리까지 고정
+		upper := SemVer{Major: base.Major + 1}
+		switch {
+		case base.Major == 0 && base.Minor == 0 && parts == 3:
+			upper = SemVer{Patch: base.Patch + 1}
+		case base.Major == 0 && parts >= 2:
+			upper = SemVer{Minor: base.Minor + 1}
+		}
+		c.check = rangeCheck(base, upper)
+	case "~":
+		upper := SemVer{Major: base.Major + 1}
+		if parts >= 2 {
+			upper = SemVer{Major: base.Major, Minor: base.Minor + 1}
+		}
+		c.check = rangeCheck(base, upper)
+	default:
+		// 생략된 자리는 와일드카드로 취급
+		switch parts {
+		case 3:
+			c.check = func(v SemVer) bool { return v.Compare(base) == 0 }
+		case 2:
+			c.check = rangeCheck(base, SemVer{Major: base.Major, Minor: base.Minor + 1})
+		default:
+			c.check = rangeCheck(base, SemVer{Major: base.Major + 1})
+		}
+	}
+
+	return c, nil
+}
+
+// Check 버전이 조건을 만족하는지 확인
+func (c Constraint) Check(v SemVer) bool {
+	return c.check(v)
+}
+
+// String 원본 조건 문자열
+func (c Constraint) String() string {
+	return c.raw
+}
+
+// rangeCheck lower 이상 upper 미만 (사전 배포 버전 제외)
+func rangeCheck(lower, upper SemVer) func(SemVer) bool {
+	return func(v SemVer) bool {
+		return v.Prerelease == "" && v.Compare(lower) >= 0 && v.Compare(upper) < 0
+	}
+}
+
+// parsePartial "1", "1.2", "1.2.x", "v1.2.3" 형식의 부분 버전 파싱
+// 지정된 자리 수를 함께 반환한다.
+func parsePartial(s string) (SemVer, int, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if v, err := ParseSemVer(s); err == nil {
+		return v, 3, nil
+	}
+
+	var nums []int
+	for _, part := range strings.Split(s, ".") {
+		if part == "x" || part == "X" || part == "*" {
+			break
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return SemVer{}, 0, i18n.Errorf("올바른 버전이 아닙니다: %s", s)
+		}
+		nums = append(nums, n)
+	}
+	if len(nums) == 0 || len(nums) > 3 {
+		return SemVer{}, 0, i18n.Errorf("올바른 버전이 아닙니다: %s", s)
+	}
+
+	var v SemVer
+	v.Major = nums[0]
+	if len(nums) > 1 {
+		v.Minor = nums[1]
+	}
+	if len(nums) > 2 {
+		v.Patch = nums[2]
+	}
+	return v, len(nums), nil
+}
@@ -0,0 +1,181 @@
+package models
+
+import "testing"
+
+func mustSemVer(t *testing.T, s string) SemVer {
+	t.Helper()
+	v, err := ParseSemVer(s)
+	if err != nil {
+		t.Fatalf("ParseSemVer(%q): %v", s, err)
+	}
+	return v
+}
+
+func TestParseSemVer(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    SemVer
+		wantErr bool
+	}{
+		{in: "1.2.3", want: SemVer{Major: 1, Minor: 2, Patch: 3}},
+		{in: "v1.2.3", want: SemVer{Major: 1, Minor: 2, Patch: 3}},
+		{in: " v0.0.1 ", want: SemVer{Patch: 1}},
+		{in: "v1.2.3-rc.1", want: SemVer{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"}},
+		{in: "1.2", wantErr: true},
+		{in: "1.2.3.4", wantErr: true},
+		{in: "1.x.3", wantErr: true},
+		{in: "1.-2.3", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSemVer(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseSemVer(%q) = %v, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseSemVer(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestSemVerCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.3.0", "1.2.9", 1},
+		{"2.0.0", "1.99.99", 1},
+		{"1.2.3-rc.1", "1.2.3", -1},
+		{"1.2.3", "1.2.3-rc.1", 1},
+		{"1.2.3-rc.2", "1.2.3-rc.10", -1},
+		{"1.2.3-rc.10", "1.2.3-rc.2", 1},
+		{"1.2.3-alpha", "1.2.3-beta", -1},
+		{"1.2.3-rc", "1.2.3-rc.1", -1},
+		{"1.2.3-1", "1.2.3-alpha", -1},
+		{"1.2.3-alpha.beta", "1.2.3-alpha.1", 1},
+		{"1.2.3-rc.1", "1.2.3-rc.1", 0},
+	}
+
+	for _, tt := range tests {
+		if got := mustSemVer(t, tt.a).Compare(mustSemVer(t, tt.b)); got != tt.want {
+			t.Errorf("%s.Compare(%s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSemVerBump(t *testing.T) {
+	tests := []struct {
+		from    string
+		part    string
+		want    string
+		wantErr bool
+	}{
+		{from: "1.2.3", part: "patch", want: "v1.2.4"},
+		{from: "1.2.3", part: "minor", want: "v1.3.0"},
+		{from: "1.2.3", part: "major", want: "v2.0.0"},
+		{from: "0.0.0", part: "patch", want: "v0.0.1"},
+		{from: "1.2.3-rc.1", part: "patch", want: "v1.2.3"},
+		{from: "1.2.3-rc.1", part: "minor", want: "v1.3.0"},
+		{from: "1.2.0-rc.1", part: "minor", want: "v1.2.0"},
+		{from: "1.2.0-rc.1", part: "major", want: "v2.0.0"},
+		{from: "2.0.0-rc.1", part: "major", want: "v2.0.0"},
+		{from: "1.2.3", part: "build", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := mustSemVer(t, tt.from).Bump(tt.part)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s.Bump(%q) = %v, want error", tt.from, tt.part, got)
+			}
+			continue
+		}
+		if err != nil || got.String() != tt.want {
+			t.Errorf("%s.Bump(%q) = %v, %v, want %s", tt.from, tt.part, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		constraint string
+		matches    []string
+		rejects    []string
+	}{
+		{"latest", []string{"0.0.1", "9.9.9"}, []string{"1.0.0-rc.1"}},
+		{"*", []string{"1.0.0"}, []string{"2.0.0-beta"}},
+		{"^1.2", []string{"1.2.0", "1.9.9"}, []string{"1.1.9", "2.0.0", "1.3.0-rc.1"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0", "0.2.2"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4"}},
+		{"~1.2.3", []string{"1.2.3", "1.2.9"}, []string{"1.3.0", "1.2.2"}},
+		{"~1", []string{"1.0.0", "1.9.0"}, []string{"2.0.0"}},
+		{">=1.0.0", []string{"1.0.0", "3.0.0"}, []string{"0.9.9", "2.0.0-rc.1"}},
+		{">1.0.0", []string{"1.0.1"}, []string{"1.0.0"}},
+		{"<=2.0.0", []string{"2.0.0", "0.1.0"}, []string{"2.0.1"}},
+		{"<2.0.0", []string{"1.9.9"}, []string{"2.0.0"}},
+		{">=1.0.0-rc.1", []string{"1.0.0-rc.2", "1.0.0-rc.10", "1.0.0"}, []string{"1.0.0-beta"}},
+		{"1.2.3", []string{"1.2.3"}, []string{"1.2.4"}},
+		{"v1.2.3", []string{"1.2.3"}, []string{"1.2.2"}},
+		{"1.2", []string{"1.2.0", "1.2.7"}, []string{"1.3.0"}},
+		{"1.2.x", []string{"1.2.5"}, []string{"1.1.0"}},
+		{"1.x", []string{"1.0.0", "1.5.0"}, []string{"2.0.0"}},
+	}
+
+	for _, tt := range tests {
+		c, err := ParseConstraint(tt.constraint)
+		if err != nil {
+			t.Errorf("ParseConstraint(%q): %v", tt.constraint, err)
+			continue
+		}
+		for _, v := range tt.matches {
+			if !c.Check(mustSemVer(t, v)) {
+				t.Errorf("%q.Check(%s) = false, want true", tt.constraint, v)
+			}
+		}
+		for _, v := range tt.rejects {
+			if c.Check(mustSemVer(t, v)) {
+				t.Errorf("%q.Check(%s) = true, want false", tt.constraint, v)
+			}
+		}
+	}
+}
+
+func TestParseConstraintInvalid(t *testing.T) {
+	for _, constraint := range []string{"^", "~x", ">=abc", "1.2.3.4", "^1.-1"} {
+		if _, err := ParseConstraint(constraint); err == nil {
+			t.Errorf("ParseConstraint(%q) = nil error, want error", constraint)
+		}
+	}
+}
+
+func TestResolveRelease(t *testing.T) {
+	tv := NewTemplateVersion("acme/rules", "v0.0.0")
+	tv.AddRelease("v1.0.0", "aaa")
+	tv.AddRelease("v1.2.0", "bbb")
+	tv.AddRelease("v2.0.0-rc.1", "ccc")
+	tv.AddRelease("v1.10.0", "ddd")
+
+	tests := []struct {
+		constraint string
+		want       string
+		found      bool
+	}{
+		{"latest", "ddd", true},
+		{"^1.2", "ddd", true},
+		{"~1.2", "bbb", true},
+		{">=2.0.0-rc.1", "ccc", true},
+		{"^3", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := tv.ResolveRelease(tt.constraint)
+		if ok != tt.found || got.Revision != tt.want {
+			t.Errorf("ResolveRelease(%q) = %q, %v, want %q, %v", tt.constraint, got.Revision, ok, tt.want, tt.found)
+		}
+	}
+}
@@ -2,8 +2,11 @@ package models
 
 import (
 	"encoding/json"
-	"fmt"
+	"sort"
+	"strings"
 	"time"
+
+	"github.com/tinysolver/rules-cli/i18n"
 )
 
 // VersionInfo 파일의 버전 정보
@@ -15,12 +18,43 @@ type VersionInfo struct {
 
 // TemplateVersion 템플릿의 버전 정보
 type TemplateVersion struct {
-	Name        string                `json:"name"`         // 템플릿 이름
-	Version     string                `json:"version"`      // 버전 (예: v1.0.0)
-	Files       map[string]VersionInfo `json:"files"`        // 파일별 버전 정보
-	Description string                `json:"description"`  // 설명
-	CreatedAt   time.Time             `json:"created_at"`   // 생성 시간
-	UpdatedAt   time.Time             `json:"updated_at"`   // 마지막 업데이트 시간
+	Name        string                 `json:"name"`                 // 템플릿 이름
+	Version     string                 `json:"version"`              // 버전 (예: v1.0.0)
+	Files       map[string]VersionInfo `json:"files"`                // 파일별 버전 정보
+	Description string                 `json:"description"`          // 설명
+	CreatedAt   time.Time              `json:"created_at"`           // 생성 시간
+	UpdatedAt   time.Time              `json:"updated_at"`           // 마지막 업데이트 시간
+	Releases    []Release              `json:"releases,omitempty"`   // 배포된 버전 목록
+	Encryption  *EncryptionInfo        `json:"encryption,omitempty"` // 파일 암호화 방식 (암호화된 템플릿만)
+}
+
+// EncryptionInfo 암호화된 템플릿의 암호화 방식과 키 유도 정보
+// 암호화된 템플릿의 매니페스트에는 평문 대신 암호문의 해시가 기록된다.
+type EncryptionInfo struct {
+	Scheme     string `json:"scheme"`               // 암호화 방식 (예: aes-256-gcm)
+	KDF        string `json:"kdf"`                  // 키 유도 방식 (pbkdf2-sha256, hkdf-sha256)
+	Salt       string `json:"salt"`                 // 키 유도 salt (base64)
+	Iterations int    `json:"iterations,omitempty"` // PBKDF2 반복 횟수
+}
+
+// Release 배포된 템플릿 버전과 대응하는 Gist 수정 이력
+type Release struct {
+	Version   string    `json:"version"`    // 시맨틱 버전 (예: v1.2.0)
+	Revision  string    `json:"revision"`   // Gist 수정 이력 SHA
+	CreatedAt time.Time `json:"created_at"` // 배포 시간
+}
+
+// IsRevision 버전 조건 대신 수정 이력 SHA를 지정했는지 확인 (7자 이상의 소문자 16진수)
+func IsRevision(ref string) bool {
+	if len(ref) < 7 {
+		return false
+	}
+	for _, c := range ref {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return false
+		}
+	}
+	return true
 }
 
 // NewTemplateVersion 새로운 템플릿 버전 생성
@@ -62,11 +96,93 @@ func (tv *TemplateVersion) UpdateFile(path string, lastModified time.Time, hash
 	}
 }
 
+// AddRelease 배포 버전 추가
+func (tv *TemplateVersion) AddRelease(version, revision string) {
+	tv.Releases = append(tv.Releases, Release{
+		Version:   version,
+		Revision:  revision,
+		CreatedAt: time.Now(),
+	})
+	tv.Version = version
+	tv.UpdatedAt = time.Now()
+}
+
+// LatestRelease 가장 높은 배포 버전 조회
+func (tv *TemplateVersion) LatestRelease() (Release, bool) {
+	return tv.ResolveRelease("latest")
+}
+
+// ResolveRelease 버전 조건을 만족하는 가장 높은 배포 버전 조회
+func (tv *TemplateVersion) ResolveRelease(constraint string) (Release, bool) {
+	c, err := ParseConstraint(constraint)
+	if err != nil {
+		return Release{}, false
+	}
+
+	var best Release
+	var bestVersion SemVer
+	found := false
+	for _, release := range tv.Releases {
+		v, err := ParseSemVer(release.Version)
+		if err != nil || !c.Check(v) {
+			continue
+		}
+		if !found || v.Compare(bestVersion) > 0 {
+			best, bestVersion, found = release, v, true
+		}
+	}
+
+	return best, found
+}
+
+// HashMismatchError 매니페스트의 해시와 내용이 일치하지 않는 파일 목록
+type HashMismatchError struct {
+	Files []string
+}
+
+func (e *HashMismatchError) Error() string {
+	return i18n.Sprintf("매니페스트의 해시와 일치하지 않는 파일이 있습니다: %s", strings.Join(e.Files, ", "))
+}
+
+// Verify 템플릿 파일 내용이 매니페스트에 기록된 해시와 일치하는지 검증
+// 매니페스트에 없는 파일이 있으면 실패한다. 이전 버전의 MD5 해시만 기록된 파일은
+// 서명되지 않은 매니페스트에서만 검증 없이 허용하며, signed가 true이면 검증 실패로 처리한다.
+func (tv *TemplateVersion) Verify(template *Template, signed bool) error {
+	var mismatched []string
+	for path, info := range tv.Files {
+		if !IsContentHash(info.Hash) {
+			if signed {
+				mismatched = append(mismatched, i18n.Sprintf("%s (지원하지 않는 해시 형식)", path))
+			}
+			continue
+		}
+		rule, exists := template.Files[path]
+		if !exists {
+			mismatched = append(mismatched, i18n.Sprintf("%s (누락)", path))
+			continue
+		}
+		if HashContent(rule.Content) != info.Hash {
+			mismatched = append(mismatched, path)
+		}
+	}
+	for path := range template.Files {
+		if _, listed := tv.Files[path]; !listed {
+			mismatched = append(mismatched, i18n.Sprintf("%s (매니페스트에 없음)", path))
+		}
+	}
+
+	if len(mismatched) > 0 {
+		sort.Strings(mismatched)
+		return &HashMismatchError{Files: mismatched}
+	}
+	return nil
+}
+
 // ToJSONString JSON 문자열로 변환
 func (v *TemplateVersion) ToJSONString() (string, error) {
 	data, err := json.MarshalIndent(v, "", "  ")
 	if err != nil {
-		return "", fmt.Errorf("JSON 변환 실패: %v", err)
+		return "", i18n.Errorf("JSON 변환 실패: %w", err)
 	}
 	return string(data), nil
 }
@@ -75,7 +191,7 @@ func (v *TemplateVersion) ToJSONString() (string, error) {
 func FromJSONString(jsonStr string) (*TemplateVersion, error) {
 	var version TemplateVersion
 	if err := json.Unmarshal([]byte(jsonStr), &version); err != nil {
-		return nil, fmt.Errorf("JSON 파싱 실패: %v", err)
+		return nil, i18n.Errorf("JSON 파싱 실패: %w", err)
 	}
 	return &version, nil
-} 
\ No newline at end of file
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTemplateVersionVerify(t *testing.T) {
+	manifest := NewTemplateVersion("acme/rules", "v1.0.0")
+	manifest.AddFile("a.mdc", time.Now(), HashContent("a"))
+	manifest.AddFile("b.mdc", time.Now(), HashContent("b"))
+
+	legacy := NewTemplateVersion("acme/rules", "v1.0.0")
+	legacy.AddFile("a.mdc", time.Now(), HashContent("a"))
+	legacy.AddFile("old.mdc", time.Now(), "0cc175b9c0f1b6a831c399e269772661")
+
+	tests := []struct {
+		name     string
+		manifest *TemplateVersion
+		files    map[string]string
+		signed   bool
+		want     []string
+	}{
+		{"일치", manifest, map[string]string{"a.mdc": "a", "b.mdc": "b"}, true, nil},
+		{"내용 변경", manifest, map[string]string{"a.mdc": "a", "b.mdc": "x"}, true, []string{"b.mdc"}},
+		{"파일 누락", manifest, map[string]string{"a.mdc": "a"}, true, []string{"b.mdc (누락)"}},
+		{"매니페스트에 없는 파일", manifest, map[string]string{"a.mdc": "a", "b.mdc": "b", "extra.mdc": "x"}, true, []string{"extra.mdc (매니페스트에 없음)"}},
+		{"서명 없는 매니페스트의 MD5 해시", legacy, map[string]string{"a.mdc": "a", "old.mdc": "changed"}, false, nil},
+		{"서명된 매니페스트의 MD5 해시", legacy, map[string]string{"a.mdc": "a", "old.mdc": "a"}, true, []string{"old.mdc (지원하지 않는 해시 형식)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := NewTemplate("acme/rules", "")
+			for path, content := range tt.files {
+				template.AddFile(path, content, path)
+			}
+
+			err := tt.manifest.Verify(template, tt.signed)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("Verify() = %v, want nil", err)
+				}
+				return
+			}
+			mismatch, ok := err.(*HashMismatchError)
+			if !ok || !reflect.DeepEqual(mismatch.Files, tt.want) {
+				t.Errorf("Verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRevision(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want bool
+	}{
+		{"a1b2c3d", true},
+		{"0123456789abcdef0123456789abcdef01234567", true},
+		{"a1b2c3", false},  // 7자 미만
+		{"A1B2C3D", false}, // 대문자
+		{"a1b2c3g", false},
+		{"^1.2.0", false},
+		{"v1.0.0", false},
+		{"latest", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsRevision(tt.ref); got != tt.want {
+			t.Errorf("IsRevision(%q) = %v, want %v", tt.ref, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+const (
+	// DefaultBaseURL GitHub OAuth 기본 주소
+	DefaultBaseURL = "https://github.com"
+	// deviceGrantType 기기 인증 흐름의 grant type
+	deviceGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	// defaultInterval 응답에 요청 간격이 없을 때 사용하는 간격 (초)
+	defaultInterval = 5
+	// slowDownInterval slow_down 응답을 받았을 때 늘리는 요청 간격 (초)
+	slowDownInterval = 5
+)
+
+// intervalUnit 요청 간격의 단위 (테스트에서 줄이기 위해 변수로 둠)
+var intervalUnit = time.Second
+
+// DeviceCode 기기 인증 요청 결과
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"` // 초
+	Interval        int    `json:"interval"`   // 초
+}
+
+// tokenResponse 토큰 요청 응답 (성공 또는 오류)
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	Scope            string `json:"scope"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	Interval         int    `json:"interval"`
+}
+
+// DeviceFlow GitHub OAuth 기기 인증 흐름
+// https://docs.github.com/apps/oauth-apps/building-oauth-apps/authorizing-oauth-apps#device-flow
+type DeviceFlow struct {
+	BaseURL    string   // OAuth 서버 주소 (예: https://github.com)
+	ClientID   string   // OAuth 앱 클라이언트 ID
+	Scopes     []string // 요청할 권한
+	HTTPClient *http.Client
+}
+
+// NewDeviceFlow 기기 인증 흐름 생성 (baseURL이 비어 있으면 github.com)
+func NewDeviceFlow(baseURL, clientID string, scopes []string) *DeviceFlow {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &DeviceFlow{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		ClientID:   clientID,
+		Scopes:     scopes,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// RequestCode 사용자에게 보여줄 인증 코드 요청
+func (f *DeviceFlow) RequestCode(ctx context.Context) (*DeviceCode, error) {
+	if f.ClientID == "" {
+		return nil, i18n.Errorf("OAuth 클라이언트 ID가 설정되지 않았습니다")
+	}
+
+	form := url.Values{
+		"client_id": {f.ClientID},
+		"scope":     {strings.Join(f.Scopes, " ")},
+	}
+
+	var code DeviceCode
+	if err := f.post(ctx, "/login/device/code", form, &code); err != nil {
+		return nil, clierr.New(clierr.Network, "인증 코드 요청 실패: %w", err)
+	}
+	if code.DeviceCode == "" || code.UserCode == "" {
+		return nil, i18n.Errorf("인증 코드 요청 실패: 응답에 코드가 없습니다")
+	}
+	if code.Interval <= 0 {
+		code.Interval = defaultInterval
+	}
+	return &code, nil
+}
+
+// PollToken 사용자가 인증을 마칠 때까지 주기적으로 토큰 요청
+func (f *DeviceFlow) PollToken(ctx context.Context, code *DeviceCode) (string, error) {
+	interval := time.Duration(code.Interval) * intervalUnit
+	if code.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(code.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+
+	form := url.Values{
+		"client_id":   {f.ClientID},
+		"device_code": {code.DeviceCode},
+		"grant_type":  {deviceGrantType},
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.Canceled {
+				return "", clierr.New(clierr.Canceled, "인증이 취소되었습니다")
+			}
+			return "", i18n.Errorf("인증 시간이 만료되었습니다. 다시 시도하세요")
+		case <-time.After(interval):
+		}
+
+		var resp tokenResponse
+		if err := f.post(ctx, "/login/oauth/access_token", form, &resp); err != nil {
+			// 요청 중에 취소되거나 만료되면 다음 대기에서 그에 맞는 오류를 반환
+			if ctx.Err() != nil {
+				continue
+			}
+			return "", clierr.New(clierr.Network, "토큰 요청 실패: %w", err)
+		}
+
+		switch resp.Error {
+		case "":
+			if resp.AccessToken == "" {
+				return "", i18n.Errorf("토큰 요청 실패: 응답에 토큰이 없습니다")
+			}
+			return resp.AccessToken, nil
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			if resp.Interval > 0 {
+				interval = time.Duration(resp.Interval) * intervalUnit
+			} else {
+				interval += slowDownInterval * intervalUnit
+			}
+		case "expired_token":
+			return "", i18n.Errorf("인증 코드가 만료되었습니다. 다시 시도하세요")
+		case "access_denied":
+			return "", i18n.Errorf("사용자가 인증을 거부했습니다")
+		default:
+			return "", i18n.Errorf("토큰 요청 실패: %s %s", resp.Error, resp.ErrorDescription)
+		}
+	}
+}
+
+// post 폼 요청을 보내고 JSON 응답 디코딩
+func (f *DeviceFlow) post(ctx context.Context, path string, form url.Values, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.BaseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,206 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tinysolver/rules-cli/clierr"
+)
+
+func init() {
+	// 요청 간격 1을 10ms로 줄여 테스트
+	intervalUnit = 10 * time.Millisecond
+}
+
+func TestRequestCode(t *testing.T) {
+	tests := []struct {
+		name         string
+		clientID     string
+		status       int
+		body         string
+		wantErr      bool
+		wantInterval int
+	}{
+		{name: "성공", clientID: "id", status: http.StatusOK,
+			body: `{"device_code":"dc","user_code":"ABCD-1234","verification_uri":"https://example.com/device","expires_in":900,"interval":3}`, wantInterval: 3},
+		{name: "간격이 없으면 기본값", clientID: "id", status: http.StatusOK,
+			body: `{"device_code":"dc","user_code":"ABCD-1234"}`, wantInterval: defaultInterval},
+		{name: "클라이언트 ID 없음", wantErr: true},
+		{name: "서버 오류", clientID: "id", status: http.StatusInternalServerError, wantErr: true},
+		{name: "응답에 코드 없음", clientID: "id", status: http.StatusOK, body: `{}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/login/device/code" {
+					t.Errorf("path = %s, want /login/device/code", r.URL.Path)
+				}
+				if r.FormValue("client_id") != tt.clientID || r.FormValue("scope") != "gist read:user" {
+					t.Errorf("form = %v", r.Form)
+				}
+				if r.Header.Get("Accept") != "application/json" {
+					t.Errorf("Accept = %q", r.Header.Get("Accept"))
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			flow := NewDeviceFlow(server.URL+"/", tt.clientID, []string{"gist", "read:user"})
+			code, err := flow.RequestCode(context.Background())
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("RequestCode() = %+v, want error", code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RequestCode(): %v", err)
+			}
+			if code.DeviceCode != "dc" || code.UserCode != "ABCD-1234" || code.Interval != tt.wantInterval {
+				t.Errorf("RequestCode() = %+v, want interval %d", code, tt.wantInterval)
+			}
+		})
+	}
+}
+
+// tokenServer 토큰 요청에 차례로 responses를 응답하고 요청 시각을 기록하는 서버
+type tokenServer struct {
+	*httptest.Server
+	mu        sync.Mutex
+	requests  []time.Time
+	responses []tokenResponse
+}
+
+func newTokenServer(t *testing.T, responses ...tokenResponse) *tokenServer {
+	s := &tokenServer{responses: responses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/login/oauth/access_token" {
+			t.Errorf("path = %s, want /login/oauth/access_token", r.URL.Path)
+		}
+		if r.FormValue("device_code") != "dc" || r.FormValue("grant_type") != deviceGrantType {
+			t.Errorf("form = %v", r.Form)
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, time.Now())
+		resp := tokenResponse{Error: "authorization_pending"}
+		if len(s.responses) > 0 {
+			resp, s.responses = s.responses[0], s.responses[1:]
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// gaps 요청 사이 간격
+func (s *tokenServer) gaps() []time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var gaps []time.Duration
+	for i := 1; i < len(s.requests); i++ {
+		gaps = append(gaps, s.requests[i].Sub(s.requests[i-1]))
+	}
+	return gaps
+}
+
+func TestPollToken(t *testing.T) {
+	pending := tokenResponse{Error: "authorization_pending"}
+	success := tokenResponse{AccessToken: "gho_token", TokenType: "bearer", Scope: "gist"}
+
+	tests := []struct {
+		name      string
+		responses []tokenResponse
+		want      string
+		wantErr   bool
+		wantCalls int
+	}{
+		{name: "대기 후 성공", responses: []tokenResponse{pending, pending, success}, want: "gho_token", wantCalls: 3},
+		{name: "사용자가 거부", responses: []tokenResponse{pending, {Error: "access_denied"}}, wantErr: true, wantCalls: 2},
+		{name: "인증 코드 만료", responses: []tokenResponse{{Error: "expired_token"}}, wantErr: true, wantCalls: 1},
+		{name: "알 수 없는 오류", responses: []tokenResponse{{Error: "incorrect_client_credentials"}}, wantErr: true, wantCalls: 1},
+		{name: "응답에 토큰 없음", responses: []tokenResponse{{}}, wantErr: true, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTokenServer(t, tt.responses...)
+			flow := NewDeviceFlow(server.URL, "id", nil)
+
+			token, err := flow.PollToken(context.Background(), &DeviceCode{DeviceCode: "dc", Interval: 1})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("PollToken() = %q, want error", token)
+				}
+			} else if err != nil || token != tt.want {
+				t.Errorf("PollToken() = %q, %v, want %q", token, err, tt.want)
+			}
+			if calls := len(server.gaps()) + 1; calls != tt.wantCalls {
+				t.Errorf("requests = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestPollTokenSlowDown(t *testing.T) {
+	success := tokenResponse{AccessToken: "gho_token"}
+
+	tests := []struct {
+		name     string
+		slowDown tokenResponse
+		wantGap  time.Duration // slow_down 이후 요청 간격의 최솟값
+	}{
+		{name: "간격 지정 없음", slowDown: tokenResponse{Error: "slow_down"}, wantGap: (1 + slowDownInterval) * intervalUnit},
+		{name: "응답의 간격 사용", slowDown: tokenResponse{Error: "slow_down", Interval: 3}, wantGap: 3 * intervalUnit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTokenServer(t, tt.slowDown, success)
+			flow := NewDeviceFlow(server.URL, "id", nil)
+
+			token, err := flow.PollToken(context.Background(), &DeviceCode{DeviceCode: "dc", Interval: 1})
+			if err != nil || token != "gho_token" {
+				t.Fatalf("PollToken() = %q, %v", token, err)
+			}
+			gaps := server.gaps()
+			if len(gaps) != 1 || gaps[0] < tt.wantGap {
+				t.Errorf("gaps = %v, want >= %v", gaps, tt.wantGap)
+			}
+		})
+	}
+}
+
+func TestPollTokenCancel(t *testing.T) {
+	server := newTokenServer(t)
+	flow := NewDeviceFlow(server.URL, "id", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(5*intervalUnit, cancel)
+
+	_, err := flow.PollToken(ctx, &DeviceCode{DeviceCode: "dc", Interval: 1})
+	if clierr.CodeOf(err) != clierr.Canceled {
+		t.Errorf("PollToken() error = %v (%s), want %s", err, clierr.CodeOf(err), clierr.Canceled)
+	}
+}
+
+func TestPollTokenExpired(t *testing.T) {
+	server := newTokenServer(t)
+	flow := NewDeviceFlow(server.URL, "id", nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*intervalUnit)
+	defer cancel()
+
+	_, err := flow.PollToken(ctx, &DeviceCode{DeviceCode: "dc", Interval: 1})
+	if err == nil || clierr.CodeOf(err) == clierr.Canceled {
+		t.Errorf("PollToken() error = %v, want expiry error", err)
+	}
+}
@@ -0,0 +1,154 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/i18n"
+	"gopkg.in/yaml.v3"
+)
+
+// Format 명령 결과 출력 형식
+type Format string
+
+const (
+	// Table 사람이 읽기 위한 형식
+	Table Format = "table"
+	// JSON JSON 형식
+	JSON Format = "json"
+	// YAML YAML 형식
+	YAML Format = "yaml"
+)
+
+var current = Table
+
+// SetFormat 출력 형식 지정 (table, json, yaml)
+func SetFormat(name string) error {
+	switch format := Format(name); format {
+	case Table, JSON, YAML:
+		current = format
+		return nil
+	}
+	return clierr.New(clierr.Validation, "지원하지 않는 출력 형식입니다: %s (table, json, yaml)", name)
+}
+
+// Current 현재 출력 형식
+func Current() Format {
+	return current
+}
+
+// Structured JSON 또는 YAML로 출력하는지 확인
+func Structured() bool {
+	return current != Table
+}
+
+// messages 진행 상황 안내를 출력할 곳
+// 구조화 출력에서는 결과와 섞이지 않도록 stderr로 보낸다.
+func messages() io.Writer {
+	if Structured() {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+// Printf 진행 상황 안내 출력 (format은 현재 언어로 번역한다)
+func Printf(format string, a ...interface{}) {
+	i18n.Fprintf(messages(), format, a...)
+}
+
+// Warnf 경고 출력 (출력 형식과 관계없이 stderr, format은 현재 언어로 번역한다)
+func Warnf(format string, a ...interface{}) {
+	i18n.Fprintf(os.Stderr, format, a...)
+}
+
+// Println 진행 상황 안내 출력
+func Println(a ...interface{}) {
+	fmt.Fprintln(messages(), a...)
+}
+
+// Print 진행 상황 안내 출력
+func Print(a ...interface{}) {
+	fmt.Fprint(messages(), a...)
+}
+
+// Result 명령 결과 출력
+// JSON/YAML이면 value를 직렬화해 stdout에 쓰고, table이면 table 함수로 출력한다.
+func Result(value interface{}, table func()) error {
+	if !Structured() {
+		if table != nil {
+			table()
+		}
+		return nil
+	}
+
+	data, err := Marshal(current, value)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+// Marshal value를 JSON 또는 YAML로 변환
+// YAML도 json 태그의 키 이름과 순서를 따르도록 JSON을 거쳐 변환한다.
+func Marshal(format Format, value interface{}) ([]byte, error) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, i18n.Errorf("결과 변환 실패: %w", err)
+	}
+	if format != YAML {
+		return append(data, '\n'), nil
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, i18n.Errorf("결과 변환 실패: %w", err)
+	}
+	blockStyle(&node)
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, i18n.Errorf("결과 변환 실패: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// blockStyle JSON에서 읽은 노드를 일반적인 YAML 블록 형식으로 변경
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// errorObject 구조화 출력의 오류 형식
+type errorObject struct {
+	Error errorDetail `json:"error"`
+}
+
+type errorDetail struct {
+	Code    clierr.Code `json:"code"`
+	Message string      `json:"message"`
+}
+
+// Error 오류를 stderr에 출력
+// JSON/YAML이면 {"error": {"code": ..., "message": ...}} 형식으로 출력한다.
+func Error(err error) {
+	if !Structured() {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	data, marshalErr := Marshal(current, errorObject{Error: errorDetail{Code: clierr.CodeOf(err), Message: err.Error()}})
+	if marshalErr != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	os.Stderr.Write(data)
+}
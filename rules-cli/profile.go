@@ -0,0 +1,125 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "계정별 프로필 관리",
+	Long: "프로필마다 토큰, 저장소, 기본 네임스페이스를 따로 저장합니다.\n" +
+		"사용할 프로필은 --profile 옵션 → CURSORRULES_PROFILE 환경 변수 → 'profile use'로 지정한 프로필 → default 순서로 정해집니다.",
+}
+
+var profileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "프로필 목록 출력",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		active, _ := config.ActiveProfile()
+		profiles := config.ListProfiles()
+		result := make([]profileInfo, 0, len(profiles))
+		for i, profile := range profiles {
+			result = append(result, profileInfo{Name: profile.Name, Active: profile.Name == active, Profile: &profiles[i]})
+		}
+
+		return printResult(result, func() {
+			for _, profile := range result {
+				mark := " "
+				if profile.Active {
+					mark = "*"
+				}
+
+				namespace := profile.Namespace
+				if namespace == "" {
+					namespace = "-"
+				}
+				host := "github.com"
+				if profile.APIURL != "" {
+					host = profile.APIURL
+				}
+				i18n.Printf("%s %s (저장소: %s, 네임스페이스: %s, 서버: %s)\n", mark, profile.Name, profile.Backend, namespace, host)
+			}
+		})
+	},
+}
+
+// profileInfo profile list 명령의 프로필 정보
+type profileInfo struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+	*config.Profile
+}
+
+var profileUseCmd = &cobra.Command{
+	Use:   "use [name]",
+	Short: "기본으로 사용할 프로필 지정",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := config.UseProfile(args[0]); err != nil {
+			return fail(clierr.Validation, err)
+		}
+		return printResult(profileInfo{Name: args[0], Active: true}, func() {
+			i18n.Printf("이제 '%s' 프로필을 사용합니다.\n", args[0])
+		})
+	},
+}
+
+var profileAddCmd = &cobra.Command{
+	Use:   "add [name]",
+	Short: "프로필 추가",
+	Long:  "프로필을 추가합니다. 토큰은 'cursorrules auth --profile <이름>'으로 설정하세요.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		backend, _ := cmd.Flags().GetString("backend")
+		namespace, _ := cmd.Flags().GetString("namespace")
+		tokenCommand, _ := cmd.Flags().GetString("token-command")
+		apiURL, _ := cmd.Flags().GetString("api-url")
+		uploadURL, _ := cmd.Flags().GetString("upload-url")
+
+		profile := config.Profile{
+			Name:         args[0],
+			Backend:      backend,
+			Namespace:    namespace,
+			TokenCommand: tokenCommand,
+			APIURL:       apiURL,
+			UploadURL:    uploadURL,
+		}
+		if err := config.AddProfile(profile); err != nil {
+			return fail(clierr.Validation, err)
+		}
+
+		return printResult(profileInfo{Name: args[0], Profile: &profile}, func() {
+			i18n.Printf("프로필 '%s'이(가) 추가되었습니다. 'cursorrules auth --profile %s'로 토큰을 설정하세요.\n", args[0], args[0])
+		})
+	},
+}
+
+var profileRemoveCmd = &cobra.Command{
+	Use:   "remove [name]",
+	Short: "프로필과 저장된 토큰 삭제",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := config.RemoveProfile(args[0]); err != nil {
+			return fail(clierr.Validation, err)
+		}
+		return printResult(profileInfo{Name: args[0]}, func() {
+			i18n.Printf("프로필 '%s'이(가) 삭제되었습니다.\n", args[0])
+		})
+	},
+}
+
+func init() {
+	profileAddCmd.Flags().String("backend", config.DefaultBackend, "템플릿 저장소")
+	profileAddCmd.Flags().String("namespace", "", "템플릿 이름 앞에 붙일 기본 네임스페이스")
+	profileAddCmd.Flags().String("token-command", "", "이 프로필의 토큰을 출력하는 외부 명령")
+	profileAddCmd.Flags().String("api-url", "", "GitHub Enterprise Server API 주소 (예: https://github.example.com/api/v3/)")
+	profileAddCmd.Flags().String("upload-url", "", "GitHub Enterprise Server 업로드 주소 (기본값: API 호스트의 /api/uploads/)")
+
+	profileCmd.AddCommand(profileListCmd)
+	profileCmd.AddCommand(profileUseCmd)
+	profileCmd.AddCommand(profileAddCmd)
+	profileCmd.AddCommand(profileRemoveCmd)
+}
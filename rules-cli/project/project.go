@@ -0,0 +1,106 @@
+package project
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/tinysolver/rules-cli/filesystem"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// FileName 프로젝트가 사용하는 템플릿을 선언하는 파일 이름
+	FileName = ".cursorrules.yaml"
+)
+
+// variablePattern 규칙 내용의 변수 자리 ({{ 이름 }})
+var variablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.-]*)\s*\}\}`)
+
+// Spec 프로젝트에서 사용하는 템플릿 하나의 설정
+type Spec struct {
+	Version   string            `yaml:"version,omitempty"`   // 버전 조건 또는 수정 이력 SHA (비어 있으면 최신)
+	Variables map[string]string `yaml:"variables,omitempty"` // 규칙 내용의 {{ 이름 }}에 채울 값
+	Ignore    []string          `yaml:"ignore,omitempty"`    // 설치하지 않을 파일 패턴 (.cursorrulesignore 형식)
+}
+
+// File 프로젝트 템플릿 선언 파일
+type File struct {
+	Templates map[string]Spec `yaml:"templates"`
+}
+
+// Load 프로젝트 디렉토리의 선언 파일 로드 (없으면 nil)
+func Load(projectDir string) (*File, error) {
+	path := filepath.Join(projectDir, FileName)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, i18n.Errorf("%s 읽기 실패: %w", FileName, err)
+	}
+
+	var file File
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, i18n.Errorf("%s 파싱 실패: %w", FileName, err)
+	}
+	if file.Templates == nil {
+		file.Templates = make(map[string]Spec)
+	}
+
+	for name, spec := range file.Templates {
+		if name == "" {
+			return nil, i18n.Errorf("%s: 템플릿 이름이 비어 있습니다", FileName)
+		}
+		if spec.Version != "" && !models.IsRevision(spec.Version) {
+			if _, err := models.ParseConstraint(spec.Version); err != nil {
+				return nil, i18n.Errorf("%s: 템플릿 '%s': %w", FileName, name, err)
+			}
+		}
+	}
+
+	return &file, nil
+}
+
+// Names 선언된 템플릿 이름 (정렬)
+func (f *File) Names() []string {
+	names := make([]string, 0, len(f.Templates))
+	for name := range f.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Render 무시 패턴에 해당하는 파일을 빼고 변수를 채운 템플릿 반환
+// 정의되지 않은 변수 자리는 그대로 둔다. 제외된 파일 경로를 함께 반환한다.
+func (s Spec) Render(template *models.Template) (*models.Template, []string) {
+	ignore := &filesystem.IgnoreMatcher{}
+	for _, pattern := range s.Ignore {
+		ignore.AddPattern(pattern, FileName)
+	}
+
+	rendered := models.NewTemplate(template.Name, template.Description)
+	var skipped []string
+	for key, rule := range template.Files {
+		if ignored, _ := ignore.Match(rule.Path); ignored {
+			skipped = append(skipped, rule.Path)
+			continue
+		}
+
+		rule.Content = variablePattern.ReplaceAllStringFunc(rule.Content, func(match string) string {
+			name := variablePattern.FindStringSubmatch(match)[1]
+			if value, ok := s.Variables[name]; ok {
+				return value
+			}
+			return match
+		})
+		rendered.Files[key] = rule
+	}
+
+	sort.Strings(skipped)
+	return rendered, skipped
+}
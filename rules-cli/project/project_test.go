@@ -0,0 +1,135 @@
+package project
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tinysolver/rules-cli/models"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string // 비어 있으면 파일을 만들지 않음
+		wantNil bool
+		wantErr bool
+		want    map[string]Spec
+	}{
+		{name: "파일 없음", wantNil: true},
+		{
+			name:    "버전 조건과 수정 이력",
+			content: "templates:\n  team-base:\n    version: ^1.2\n    variables:\n      app_name: shop\n    ignore:\n      - legacy/\n  acme/security:\n    version: a1b2c3d4\n  latest: {}\n",
+			want: map[string]Spec{
+				"team-base":     {Version: "^1.2", Variables: map[string]string{"app_name": "shop"}, Ignore: []string{"legacy/"}},
+				"acme/security": {Version: "a1b2c3d4"},
+				"latest":        {},
+			},
+		},
+		{name: "템플릿 없음", content: "# 비어 있음\n", want: map[string]Spec{}},
+		{name: "빈 템플릿 이름", content: "templates:\n  \"\": {}\n", wantErr: true},
+		{name: "잘못된 버전 조건", content: "templates:\n  team-base:\n    version: not-a-version\n", wantErr: true},
+		{name: "짧은 수정 이력은 버전 조건으로 검사", content: "templates:\n  team-base:\n    version: a1b2c3\n", wantErr: true},
+		{name: "YAML 오류", content: "templates: [\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.content != "" {
+				if err := os.WriteFile(filepath.Join(dir, FileName), []byte(tt.content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			file, err := Load(dir)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Load() = %+v, want error", file)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load(): %v", err)
+			}
+			if tt.wantNil {
+				if file != nil {
+					t.Errorf("Load() = %+v, want nil", file)
+				}
+				return
+			}
+
+			if len(file.Templates) != len(tt.want) {
+				t.Errorf("Load() templates = %v, want %v", file.Names(), tt.want)
+			}
+			for name, want := range tt.want {
+				got, ok := file.Templates[name]
+				if !ok {
+					t.Errorf("Load() missing %s", name)
+					continue
+				}
+				if got.Version != want.Version || len(got.Variables) != len(want.Variables) ||
+					strings.Join(got.Ignore, ",") != strings.Join(want.Ignore, ",") {
+					t.Errorf("Templates[%s] = %+v, want %+v", name, got, want)
+				}
+				for key, value := range want.Variables {
+					if got.Variables[key] != value {
+						t.Errorf("Templates[%s].Variables[%s] = %q, want %q", name, key, got.Variables[key], value)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	file := &File{Templates: map[string]Spec{"b": {}, "acme/a": {}, "a": {}}}
+	if got := strings.Join(file.Names(), ","); got != "a,acme/a,b" {
+		t.Errorf("Names() = %s, want a,acme/a,b", got)
+	}
+}
+
+func TestRender(t *testing.T) {
+	template := models.NewTemplate("team-base", "설명")
+	template.AddFile("app.mdc", "App: {{ app_name }} / {{app_name}} / {{ env.stage }} / {{ undefined }} / { app_name }", "app.mdc")
+	template.AddFile("legacy/old.mdc", "{{ app_name }}", "legacy/old.mdc")
+	template.AddFile("b.draft.mdc", "draft", "b.draft.mdc")
+	template.AddFile("a.draft.mdc", "draft", "a.draft.mdc")
+	template.AddFile("keep.draft.mdc", "keep", "keep.draft.mdc")
+
+	spec := Spec{
+		Variables: map[string]string{"app_name": "shop", "env.stage": "prod"},
+		Ignore:    []string{"legacy/", "*.draft.mdc", "!keep.draft.mdc"},
+	}
+	rendered, skipped := spec.Render(template)
+
+	if rendered.Name != template.Name || rendered.Description != template.Description {
+		t.Errorf("Render() = %s (%s), want %s (%s)", rendered.Name, rendered.Description, template.Name, template.Description)
+	}
+	if got, want := rendered.Files["app.mdc"].Content, "App: shop / shop / prod / {{ undefined }} / { app_name }"; got != want {
+		t.Errorf("app.mdc = %q, want %q", got, want)
+	}
+	if _, ok := rendered.Files["keep.draft.mdc"]; !ok {
+		t.Error("keep.draft.mdc was skipped, want kept by negated pattern")
+	}
+	if got, want := strings.Join(skipped, ","), "a.draft.mdc,b.draft.mdc,legacy/old.mdc"; got != want {
+		t.Errorf("skipped = %s, want %s", got, want)
+	}
+	if len(rendered.Files) != 2 {
+		t.Errorf("rendered files = %d, want 2", len(rendered.Files))
+	}
+	if !strings.HasPrefix(template.Files["app.mdc"].Content, "App: {{ app_name }}") || len(template.Files) != 5 {
+		t.Error("Render() modified the original template")
+	}
+}
+
+func TestRenderEmptySpec(t *testing.T) {
+	template := models.NewTemplate("team-base", "")
+	template.AddFile("a.mdc", "{{ name }}", "a.mdc")
+
+	rendered, skipped := Spec{}.Render(template)
+	if len(skipped) != 0 || rendered.Files["a.mdc"].Content != "{{ name }}" {
+		t.Errorf("Render() = %v, %v, want unchanged", rendered.Files, skipped)
+	}
+}
@@ -0,0 +1,82 @@
+package prompt
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/terminal"
+)
+
+// 질문은 결과 출력과 섞이지 않도록 stderr에 쓰고, 답은 stdin에서 읽는다.
+// 입력을 받을 수 없으면(--no-input 또는 stdin이 터미널이 아님) 기다리지 않고
+// 대신 지정할 옵션을 안내하는 오류를 반환한다.
+
+var (
+	// assumeYes 확인 질문에 묻지 않고 yes로 답함 (--yes)
+	assumeYes bool
+	// noInput 입력을 받지 않음 (--no-input)
+	noInput bool
+)
+
+// Configure 전역 옵션 지정
+func Configure(yes, disableInput bool) {
+	assumeYes = yes
+	noInput = disableInput
+}
+
+// Interactive 사용자에게 입력을 받을 수 있는지 확인
+func Interactive() bool {
+	return !noInput && terminal.IsTerminal(os.Stdin)
+}
+
+// Confirm y/N 확인 질문 (question은 번역한 문장)
+// --yes이면 묻지 않고 true를 반환한다. 입력을 받을 수 없으면 --yes와 flags를 안내하는 오류를 반환한다.
+// 답을 읽지 못하면(입력이 끝난 경우 포함) 거절로 보지 않고 오류를 반환한다.
+func Confirm(question string, flags ...string) (bool, error) {
+	if assumeYes {
+		return true, nil
+	}
+	if !Interactive() {
+		return false, clierr.New(clierr.Validation, "%s: 입력을 받을 수 없어 확인할 수 없습니다. 계속하려면 %s 옵션을 지정하세요",
+			strings.TrimSpace(question), strings.Join(append([]string{"--yes"}, flags...), ", "))
+	}
+
+	fmt.Fprint(os.Stderr, question+" (y/N): ")
+	answer, err := terminal.ReadLine(os.Stdin)
+	if err != nil {
+		return false, i18n.Errorf("입력 실패: %w", err)
+	}
+	return strings.ToLower(strings.TrimSpace(answer)) == "y", nil
+}
+
+// Piped 파이프로 stdin에 전달한 값 한 줄 읽기 (예: echo $TOKEN | cursorrules auth)
+// 질문이 아니므로 --no-input과 관계없이 읽으며, stdin이 터미널이면 읽지 않고 ok로 false를 반환한다.
+func Piped() (value string, ok bool, err error) {
+	if terminal.IsTerminal(os.Stdin) {
+		return "", false, nil
+	}
+	value, err = terminal.ReadLine(os.Stdin)
+	if err != nil && err != io.EOF {
+		return "", true, i18n.Errorf("입력 실패: %w", err)
+	}
+	return value, true, nil
+}
+
+// Secret 화면에 표시하지 않고 값 입력 (question은 번역한 문장)
+// 입력을 받을 수 없으면 hint(대신 값을 지정하는 방법)를 안내하는 오류를 반환한다.
+func Secret(question, hint string) (string, error) {
+	if !Interactive() {
+		return "", clierr.New(clierr.Validation, "%s: 입력을 받을 수 없습니다. %s", question, hint)
+	}
+
+	fmt.Fprint(os.Stderr, question+": ")
+	value, err := terminal.ReadPassword(os.Stdin)
+	if err != nil {
+		return "", i18n.Errorf("입력 실패: %w", err)
+	}
+	return value, nil
+}
@@ -0,0 +1,222 @@
+package secret
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tinysolver/rules-cli/encryption"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+const (
+	// secretsFile 암호화된 비밀 값 파일 이름 (설정 디렉토리 기준)
+	secretsFile = "secrets.enc"
+	// keyFile 비밀 값 파일의 암호화 키 파일 이름 (설정 디렉토리 기준)
+	keyFile = "secrets.key"
+	// keySize 무작위 키 크기
+	keySize = 32
+)
+
+// fileData 암호화 파일 내용
+type fileData struct {
+	Encryption models.EncryptionInfo `json:"encryption"`
+	Secrets    map[string]string     `json:"secrets"` // 키별 암호화된 값
+}
+
+// fileStore 암호화 파일 저장소
+// 값은 처음 저장할 때 만든 무작위 키(0600 권한의 secrets.key)로 암호화한다.
+// 값 파일만 백업/동기화되거나 유출된 경우에는 노출되지 않지만, 키 파일이 같은 디렉토리에 있으므로
+// 같은 사용자 권한으로 실행되는 프로그램이나 설정 디렉토리 전체를 얻은 사람은 값을 읽을 수 있다.
+// OS 키링을 사용할 수 없는 환경을 위한 대안이며, 키링과 같은 수준의 보호를 제공하지는 않는다.
+type fileStore struct {
+	path    string
+	keyPath string
+}
+
+func newFileStore(configDir string) *fileStore {
+	return &fileStore{
+		path:    filepath.Join(configDir, secretsFile),
+		keyPath: filepath.Join(configDir, keyFile),
+	}
+}
+
+func (s *fileStore) ID() string {
+	return ProviderFile
+}
+
+func (s *fileStore) Name() string {
+	return i18n.Sprintf("암호화 파일 (%s)", s.path)
+}
+
+func (s *fileStore) Get(key string) (string, error) {
+	secretKey, err := s.readKey()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+
+	data, err := s.load(secretKey)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, ok := data.Secrets[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	k, err := encryption.DeriveKey(data.Encryption, secretKey)
+	if err != nil {
+		return "", err
+	}
+	return k.Open(key, sealed)
+}
+
+func (s *fileStore) Set(key, value string) error {
+	secretKey, err := s.readKey()
+	if os.IsNotExist(err) {
+		secretKey, err = s.createKey()
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := s.load(secretKey)
+	if err != nil {
+		return err
+	}
+
+	k, err := encryption.DeriveKey(data.Encryption, secretKey)
+	if err != nil {
+		return err
+	}
+	sealed, err := k.Seal(key, value)
+	if err != nil {
+		return err
+	}
+	data.Secrets[key] = sealed
+
+	return s.save(data)
+}
+
+func (s *fileStore) Delete(key string) error {
+	secretKey, err := s.readKey()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	data, err := s.load(secretKey)
+	if err != nil {
+		return err
+	}
+	if _, ok := data.Secrets[key]; !ok {
+		return nil
+	}
+	delete(data.Secrets, key)
+	return s.save(data)
+}
+
+// readKey 키 파일 읽기 (없으면 os.IsNotExist 오류)
+func (s *fileStore) readKey() ([]byte, error) {
+	secretKey, err := os.ReadFile(s.keyPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, err
+		}
+		return nil, i18n.Errorf("비밀 값 키 파일을 읽을 수 없습니다: %w", err)
+	}
+	if len(secretKey) != keySize {
+		return nil, i18n.Errorf("비밀 값 키 파일이 올바르지 않습니다: %s", s.keyPath)
+	}
+	return secretKey, nil
+}
+
+// createKey 무작위 키를 만들어 0600 권한으로 저장
+// 키가 없으면 기존 값 파일은 복호화할 수 없으므로 함께 삭제한다.
+func (s *fileStore) createKey() ([]byte, error) {
+	secretKey := make([]byte, keySize)
+	if _, err := rand.Read(secretKey); err != nil {
+		return nil, i18n.Errorf("비밀 값 키 생성 실패: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.keyPath), 0700); err != nil {
+		return nil, i18n.Errorf("설정 디렉토리 생성 실패: %w", err)
+	}
+	file, err := os.OpenFile(s.keyPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, i18n.Errorf("비밀 값 키 파일 저장 실패: %w", err)
+	}
+	if _, err := file.Write(secretKey); err != nil {
+		file.Close()
+		os.Remove(s.keyPath)
+		return nil, i18n.Errorf("비밀 값 키 파일 저장 실패: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, i18n.Errorf("비밀 값 키 파일 저장 실패: %w", err)
+	}
+
+	if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		return nil, i18n.Errorf("비밀 값 파일 저장 실패: %w", err)
+	}
+	return secretKey, nil
+}
+
+// load 파일 로드 (없으면 새 salt로 빈 저장소 생성)
+func (s *fileStore) load(secretKey []byte) (*fileData, error) {
+	raw, err := os.ReadFile(s.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, i18n.Errorf("비밀 값 파일을 읽을 수 없습니다: %w", err)
+		}
+
+		key, err := encryption.NewKey(encryption.KDFKeyFile, secretKey)
+		if err != nil {
+			return nil, err
+		}
+		return &fileData{Encryption: *key.Info(), Secrets: make(map[string]string)}, nil
+	}
+
+	var data fileData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, i18n.Errorf("비밀 값 파일 파싱 실패: %w", err)
+	}
+	if data.Secrets == nil {
+		data.Secrets = make(map[string]string)
+	}
+	return &data, nil
+}
+
+// save 0600 권한으로 파일 저장 (임시 파일에 쓴 뒤 교체)
+func (s *fileStore) save(data *fileData) error {
+	raw, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return i18n.Errorf("비밀 값 파일 변환 실패: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return i18n.Errorf("설정 디렉토리 생성 실패: %w", err)
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	tmp := fmt.Sprintf("%s.%x.tmp", s.path, suffix)
+	if err := os.WriteFile(tmp, raw, 0600); err != nil {
+		return i18n.Errorf("비밀 값 파일 저장 실패: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		os.Remove(tmp)
+		return i18n.Errorf("비밀 값 파일 저장 실패: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package secret
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestFileStore(t *testing.T) {
+	dir := t.TempDir()
+	store := newFileStore(dir)
+
+	if _, err := store.Get("token"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get() before Set error = %v, want ErrNotFound", err)
+	}
+
+	if err := store.Set("token", "ghp_secret"); err != nil {
+		t.Fatalf("Set(): %v", err)
+	}
+	if got, err := store.Get("token"); err != nil || got != "ghp_secret" {
+		t.Errorf("Get() = %q, %v, want %q", got, err, "ghp_secret")
+	}
+
+	// 값 파일에는 평문이 남지 않고, 키는 0600 권한의 별도 파일에 저장된다
+	raw, err := os.ReadFile(filepath.Join(dir, secretsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "ghp_secret") {
+		t.Error("비밀 값 파일에 평문이 저장되었습니다")
+	}
+	info, err := os.Stat(filepath.Join(dir, keyFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0600 {
+		t.Errorf("키 파일 권한 = %v, want 0600", info.Mode().Perm())
+	}
+
+	// 다른 키로는 값 파일을 읽을 수 없다
+	if err := os.Remove(filepath.Join(dir, keyFile)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get("token"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() without key error = %v, want ErrNotFound", err)
+	}
+	if err := store.Set("other", "value"); err != nil {
+		t.Fatalf("Set() with new key: %v", err)
+	}
+	if _, err := store.Get("token"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() after key reset error = %v, want ErrNotFound", err)
+	}
+
+	if err := store.Delete("other"); err != nil {
+		t.Fatalf("Delete(): %v", err)
+	}
+	if _, err := store.Get("other"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() after Delete error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,53 @@
+package secret
+
+import (
+	"errors"
+
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/zalando/go-keyring"
+)
+
+// keyringService 키링에 저장할 때 사용하는 서비스 이름
+const keyringService = "cursorrules-cli"
+
+// keyringStore OS 키링 저장소
+// Linux에서는 D-Bus Secret Service(GNOME Keyring, KWallet 등)를 사용한다.
+type keyringStore struct{}
+
+func newKeyringStore() *keyringStore {
+	return &keyringStore{}
+}
+
+// available 키링 서비스에 접근할 수 있는지 확인
+func (s *keyringStore) available() bool {
+	_, err := keyring.Get(keyringService, "probe")
+	return err == nil || errors.Is(err, keyring.ErrNotFound)
+}
+
+func (s *keyringStore) ID() string {
+	return ProviderKeyring
+}
+
+func (s *keyringStore) Name() string {
+	return i18n.T("OS 키링")
+}
+
+func (s *keyringStore) Get(key string) (string, error) {
+	value, err := keyring.Get(keyringService, key)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", ErrNotFound
+	}
+	return value, err
+}
+
+func (s *keyringStore) Set(key, value string) error {
+	return keyring.Set(keyringService, key, value)
+}
+
+func (s *keyringStore) Delete(key string) error {
+	err := keyring.Delete(keyringService, key)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return nil
+	}
+	return err
+}
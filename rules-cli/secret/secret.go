@@ -0,0 +1,52 @@
+package secret
+
+import (
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+const (
+	// ProviderAuto 사용 가능한 OS 키링을 우선 사용하고, 없으면 암호화 파일 사용
+	ProviderAuto = "auto"
+	// ProviderKeyring OS 키링 (Linux에서는 Secret Service D-Bus API)
+	ProviderKeyring = "keyring"
+	// ProviderFile 설정 디렉토리의 암호화 파일
+	ProviderFile = "file"
+)
+
+// ErrNotFound 저장소에 해당 키가 없는 경우
+var ErrNotFound = i18n.NewError("저장된 값이 없습니다")
+
+// Store 토큰 등 비밀 값 저장소
+type Store interface {
+	// ID 저장소 식별자 (ProviderKeyring, ProviderFile)
+	ID() string
+	// Name 저장소 이름 (상태 출력용)
+	Name() string
+	// Get 저장된 값 조회 (없으면 ErrNotFound)
+	Get(key string) (string, error)
+	// Set 값 저장
+	Set(key, value string) error
+	// Delete 값 삭제 (없어도 오류 아님)
+	Delete(key string) error
+}
+
+// Open 설정한 방식의 비밀 값 저장소 열기
+// auto이면 OS 키링을 사용할 수 있는지 확인하고, 사용할 수 없으면 configDir의 암호화 파일을 사용한다.
+func Open(provider, configDir string) (Store, error) {
+	switch provider {
+	case "", ProviderAuto:
+		if store := newKeyringStore(); store.available() {
+			return store, nil
+		}
+		return newFileStore(configDir), nil
+	case ProviderKeyring:
+		store := newKeyringStore()
+		if !store.available() {
+			return nil, i18n.Errorf("OS 키링을 사용할 수 없습니다 (Secret Service가 실행 중인지 확인하세요)")
+		}
+		return store, nil
+	case ProviderFile:
+		return newFileStore(configDir), nil
+	}
+	return nil, i18n.Errorf("지원하지 않는 비밀 값 저장소입니다: %s (auto, keyring, file)", provider)
+}
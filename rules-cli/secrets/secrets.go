@@ -0,0 +1,167 @@
+package secrets
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/models"
+)
+
+const (
+	// entropyMinLength 엔트로피 검사 대상 문자열의 최소 길이
+	entropyMinLength = 20
+	// entropyThreshold 비밀 값으로 의심할 문자당 섀넌 엔트로피 (bit)
+	entropyThreshold = 4.0
+	// identifierWordLength 식별자로 볼 단어의 평균 길이 (무작위 문자열은 단어가 짧게 끊긴다)
+	identifierWordLength = 4
+)
+
+// Rule 비밀 정보 탐지 규칙
+type Rule struct {
+	Name    string
+	Pattern *regexp.Regexp
+}
+
+// builtinRules 기본 탐지 규칙
+var builtinRules = []Rule{
+	{Name: "GitHub 토큰", Pattern: regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{Name: "AWS 액세스 키", Pattern: regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{Name: "AWS 비밀 키", Pattern: regexp.MustCompile(`(?i)aws.{0,20}secret.{0,20}[:=]\s*["']?[A-Za-z0-9/+=]{40}\b`)},
+	{Name: "개인 키", Pattern: regexp.MustCompile(`-----BEGIN ([A-Z0-9]+ )*PRIVATE KEY( BLOCK)?-----`)},
+}
+
+// tokenPattern 엔트로피 검사 대상 문자열 (base64, hex, URL-safe 토큰 등)
+// 파일 경로가 걸리지 않도록 '/'와 '.'은 구분자로 취급한다.
+var tokenPattern = regexp.MustCompile(`[A-Za-z0-9+=_\-]{20,}`)
+
+// integrityPattern 공개된 파일의 해시인 하위 리소스 무결성 값 (엔트로피 검사에서 제외)
+var integrityPattern = regexp.MustCompile(`\bsha(256|384|512)-[A-Za-z0-9+/]+=*`)
+
+// wordPattern 식별자의 단어 (camelCase, snake_case, kebab-case의 영문자 부분)
+var wordPattern = regexp.MustCompile(`[A-Z]?[a-z]+|[A-Z]+`)
+
+// Finding 탐지된 비밀 정보
+type Finding struct {
+	Path  string // 파일 경로
+	Line  int    // 줄 번호 (1부터)
+	Rule  string // 탐지 규칙 이름
+	Match string // 탐지된 문자열 (일부 가림)
+}
+
+// String "파일:줄: 규칙 (값)" 형식 문자열
+func (f Finding) String() string {
+	return fmt.Sprintf("%s:%d: %s (%s)", f.Path, f.Line, i18n.T(f.Rule), f.Match)
+}
+
+// Scanner 규칙 파일의 비밀 정보 검사기
+type Scanner struct {
+	rules []Rule
+}
+
+// NewScanner 기본 규칙과 사용자 정의 정규식으로 검사기 생성
+func NewScanner(patterns []string) (*Scanner, error) {
+	rules := append([]Rule(nil), builtinRules...)
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, i18n.Errorf("올바르지 않은 비밀 정보 패턴입니다 (%s): %w", pattern, err)
+		}
+		rules = append(rules, Rule{Name: i18n.Sprintf("사용자 정의 패턴 %s", pattern), Pattern: re})
+	}
+	return &Scanner{rules: rules}, nil
+}
+
+// ScanTemplate 템플릿의 모든 파일 검사 (파일, 줄 순서로 정렬)
+func (s *Scanner) ScanTemplate(template *models.Template) []Finding {
+	var findings []Finding
+	for filePath, rule := range template.Files {
+		findings = append(findings, s.Scan(filePath, rule.Content)...)
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Path != findings[j].Path {
+			return findings[i].Path < findings[j].Path
+		}
+		return findings[i].Line < findings[j].Line
+	})
+	return findings
+}
+
+// Scan 파일 내용 검사
+// 한 줄에서 규칙에 걸린 값은 엔트로피 검사에서 다시 보고하지 않는다.
+func (s *Scanner) Scan(filePath, content string) []Finding {
+	var findings []Finding
+	for i, line := range strings.Split(content, "\n") {
+		matched := false
+		for _, rule := range s.rules {
+			if m := rule.Pattern.FindString(line); m != "" {
+				findings = append(findings, Finding{Path: filePath, Line: i + 1, Rule: rule.Name, Match: redact(m)})
+				matched = true
+			}
+		}
+		if matched {
+			continue
+		}
+
+		for _, token := range tokenPattern.FindAllString(integrityPattern.ReplaceAllString(line, " "), -1) {
+			if isHighEntropy(token) {
+				findings = append(findings, Finding{Path: filePath, Line: i + 1, Rule: "높은 엔트로피 문자열", Match: redact(token)})
+				break
+			}
+		}
+	}
+	return findings
+}
+
+// isHighEntropy 무작위로 생성된 키처럼 보이는 문자열인지 확인
+// 숫자와 영문자가 섞여 있고 문자당 엔트로피가 기준 이상이어야 하며, 긴 식별자는 제외한다.
+func isHighEntropy(token string) bool {
+	if len(token) < entropyMinLength {
+		return false
+	}
+	if !strings.ContainsAny(token, "0123456789") || !strings.ContainsAny(strings.ToLower(token), "abcdefghijklmnopqrstuvwxyz") {
+		return false
+	}
+	return shannonEntropy(token) >= entropyThreshold && !isIdentifier(token)
+}
+
+// isIdentifier 단어를 이어 붙인 식별자처럼 보이는지 확인 (예: getUserSettingsV2, http2_request_timeout)
+func isIdentifier(token string) bool {
+	words := wordPattern.FindAllString(token, -1)
+	if len(words) == 0 {
+		return false
+	}
+	letters := 0
+	for _, word := range words {
+		letters += len(word)
+	}
+	return letters >= identifierWordLength*len(words)
+}
+
+// shannonEntropy 문자당 섀넌 엔트로피 (bit)
+func shannonEntropy(s string) float64 {
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+
+	var entropy float64
+	n := float64(len(s))
+	for _, count := range counts {
+		p := float64(count) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// redact 탐지된 값의 앞부분만 남기고 가림
+func redact(s string) string {
+	if len(s) <= 8 {
+		return strings.Repeat("*", len(s))
+	}
+	return s[:4] + strings.Repeat("*", 8)
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/cobra"
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/prompt"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "CLI 설정 조회 및 변경",
+	Long: "CLI 설정을 조회하고 변경합니다. --project 옵션을 사용하면 전역 설정 대신\n" +
+		"프로젝트 루트의 " + config.ProjectConfigFile + " 파일에 저장하며, 프로젝트 설정이 전역 설정보다 우선합니다.",
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get [key]",
+	Short: "설정 값 출력",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		value, err := config.GetSetting(args[0])
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+		return printResult(newSettingInfo(value), func() {
+			fmt.Println(config.FormatValue(value.Value))
+		})
+	},
+}
+
+// settingInfo config 명령의 설정 값 정보
+type settingInfo struct {
+	Key         string      `json:"key"`
+	Value       interface{} `json:"value"`
+	Scope       string      `json:"scope"` // 값을 읽은 위치 (project, global, default)
+	Description string      `json:"description"`
+}
+
+// newSettingInfo 설정 값을 출력용 정보로 변환
+func newSettingInfo(value config.SettingValue) settingInfo {
+	return settingInfo{Key: value.Key, Value: value.Value, Scope: value.Scope, Description: i18n.T(value.Description)}
+}
+
+// printSetting 변경한 설정 값 출력
+func printSetting(key string) error {
+	value, err := config.GetSetting(key)
+	if err != nil {
+		return fail(clierr.Validation, err)
+	}
+	return printResult(newSettingInfo(value), func() {
+		fmt.Printf("%s = %s (%s)\n", key, config.FormatValue(value.Value), value.Scope)
+	})
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set [key] [value...]",
+	Short: "설정 값 변경",
+	Long:  "설정 값을 변경합니다. 목록 값은 쉼표로 구분하거나 여러 인자로 지정합니다.",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project, _ := cmd.Flags().GetBool("project")
+		if err := config.SetSetting(args[0], args[1:], project); err != nil {
+			return fail(clierr.Validation, err)
+		}
+		return printSetting(args[0])
+	},
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset [key]",
+	Short: "설정 값 삭제 (기본값으로 되돌림)",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project, _ := cmd.Flags().GetBool("project")
+		if err := config.UnsetSetting(args[0], project); err != nil {
+			return fail(clierr.Validation, err)
+		}
+		return printSetting(args[0])
+	},
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "모든 설정 값 출력",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		values, err := config.ListSettings()
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		result := make([]settingInfo, 0, len(values))
+		for _, value := range values {
+			result = append(result, newSettingInfo(value))
+		}
+		return printResult(result, func() {
+			for _, value := range values {
+				fmt.Printf("%s = %s (%s)\n", value.Key, config.FormatValue(value.Value), value.Scope)
+			}
+		})
+	},
+}
+
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "편집기로 설정 파일 수정",
+	Long:  "설정 파일을 편집기로 엽니다. 저장한 내용에 알 수 없는 키나 잘못된 값이 있으면 반영하지 않습니다.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project, _ := cmd.Flags().GetBool("project")
+
+		// 편집기는 터미널 입력이 필요하므로 입력을 받을 수 없으면 기다리지 않고 실패
+		if !prompt.Interactive() {
+			return fail(clierr.Validation, i18n.NewError("입력을 받을 수 없어 편집기를 실행할 수 없습니다. 'cursorrules config set'으로 설정을 변경하세요"))
+		}
+
+		var path string
+		var err error
+		if project {
+			path, err = config.ProjectConfigPath()
+		} else {
+			if err = config.InitConfig(); err == nil {
+				path, err = config.GetConfigPath()
+			}
+		}
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		if err := editConfigFile(path, project); err != nil {
+			return fail(clierr.Validation, err)
+		}
+		return printResult(configEditResult{Path: path}, func() {
+			i18n.Printf("%s이(가) 저장되었습니다.\n", path)
+		})
+	},
+}
+
+// configEditResult config edit 명령 결과
+type configEditResult struct {
+	Path string `json:"path"` // 저장한 설정 파일
+}
+
+// editConfigFile 설정 파일 사본을 편집기로 열고, 검증을 통과하면 원본을 교체
+func editConfigFile(path string, project bool) error {
+	original, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return i18n.Errorf("설정 파일을 읽을 수 없습니다: %w", err)
+		}
+		original = []byte("{}\n")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".config-edit-*.json")
+	if err != nil {
+		return i18n.Errorf("임시 파일 생성 실패: %w", err)
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(original); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return i18n.Errorf("임시 파일 저장 실패: %w", err)
+	}
+	tmp.Close()
+
+	if err := runEditor(tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	// 검증에 실패하면 수정한 내용을 잃지 않도록 임시 파일을 남김
+	if err := config.ValidateFile(tmpPath, project); err != nil {
+		return i18n.Errorf("%w\n수정한 내용은 반영되지 않았으며 %s에 남아 있습니다", err, tmpPath)
+	}
+
+	perm := os.FileMode(0600)
+	if project {
+		perm = 0644
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return i18n.Errorf("설정 파일 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// runEditor 설정한 편집기로 파일 열기
+func runEditor(path string) error {
+	editor := config.GetEditor()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", editor+" "+path)
+	} else {
+		// "code --wait"처럼 인자가 있는 편집기 설정을 허용하기 위해 셸로 실행
+		cmd = exec.Command("sh", "-c", editor+` "$1"`, "sh", path)
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return i18n.Errorf("편집기 실행 실패 (%s): %w", editor, err)
+	}
+	return nil
+}
+
+func init() {
+	configSetCmd.Flags().Bool("project", false, "프로젝트 설정 파일에 저장")
+	configUnsetCmd.Flags().Bool("project", false, "프로젝트 설정 파일에서 삭제")
+	configEditCmd.Flags().Bool("project", false, "프로젝트 설정 파일 수정")
+
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configEditCmd)
+}
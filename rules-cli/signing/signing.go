@@ -0,0 +1,302 @@
+package signing
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/config"
+	"github.com/tinysolver/rules-cli/i18n"
+)
+
+const (
+	// Algorithm 서명 알고리즘
+	Algorithm = "ed25519"
+	// keysDir 서명 키를 저장하는 디렉토리 (설정 디렉토리 기준)
+	keysDir = "keys"
+	// trustedKeysFile 신뢰하는 공개 키 목록 파일 (설정 디렉토리 기준)
+	trustedKeysFile = "trusted_keys"
+	// DefaultKeyName 기본 서명 키 이름
+	DefaultKeyName = "default"
+)
+
+// keyNamePattern 서명 키 이름 형식 (파일 이름으로 쓰이므로 프로필 이름과 같은 규칙)
+var keyNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+var (
+	// ErrUnsigned 서명이 없는 템플릿
+	ErrUnsigned = i18n.NewError("템플릿에 서명이 없습니다")
+	// ErrUntrustedKey 신뢰 목록에 없는 키로 서명된 템플릿
+	ErrUntrustedKey = i18n.NewError("신뢰하지 않는 키로 서명되었습니다")
+	// ErrInvalidSignature 서명이 내용과 일치하지 않는 템플릿
+	ErrInvalidSignature = i18n.NewError("서명이 올바르지 않습니다")
+)
+
+// Signature 매니페스트 서명
+type Signature struct {
+	Algorithm string `json:"algorithm"`
+	KeyID     string `json:"key_id"`
+	Signature string `json:"signature"` // base64
+}
+
+// TrustedKey 신뢰하는 공개 키
+type TrustedKey struct {
+	ID      string
+	Key     ed25519.PublicKey
+	Comment string
+}
+
+// KeyID 공개 키 식별자 (SHA-256 앞 16자리)
+func KeyID(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return fmt.Sprintf("%x", sum[:8])
+}
+
+// FormatPublicKey 공유용 공개 키 한 줄 ("ed25519 <base64> <설명>")
+func FormatPublicKey(pub ed25519.PublicKey, comment string) string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s %s", Algorithm, base64.StdEncoding.EncodeToString(pub), comment))
+}
+
+// ParsePublicKey 공유용 공개 키 한 줄 파싱
+func ParsePublicKey(line string) (ed25519.PublicKey, string, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 || fields[0] != Algorithm {
+		return nil, "", i18n.Errorf("올바른 공개 키 형식이 아닙니다 (예: ed25519 <base64> <설명>)")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil || len(raw) != ed25519.PublicKeySize {
+		return nil, "", i18n.Errorf("올바른 ed25519 공개 키가 아닙니다")
+	}
+
+	return ed25519.PublicKey(raw), strings.Join(fields[2:], " "), nil
+}
+
+// keyPaths 키 이름에 해당하는 개인 키/공개 키 파일 경로
+func keyPaths(name string) (string, string, error) {
+	if !keyNamePattern.MatchString(name) {
+		return "", "", clierr.New(clierr.Validation, "서명 키 이름은 영문 소문자, 숫자, '-', '_'만 사용할 수 있습니다: %s", name)
+	}
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", "", err
+	}
+	dir := filepath.Join(configDir, keysDir)
+	return filepath.Join(dir, name+".key"), filepath.Join(dir, name+".pub"), nil
+}
+
+// GenerateKey 새 서명 키 생성 후 저장
+// 개인 키는 0600 권한의 PEM 파일로, 공개 키는 공유용 한 줄 형식으로 저장한다.
+func GenerateKey(name string) (ed25519.PublicKey, error) {
+	privPath, pubPath, err := keyPaths(name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(privPath); err == nil {
+		return nil, clierr.New(clierr.Conflict, "'%s' 키가 이미 존재합니다: %s", name, privPath)
+	}
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, i18n.Errorf("키 생성 실패: %w", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, i18n.Errorf("키 변환 실패: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(privPath), 0700); err != nil {
+		return nil, i18n.Errorf("키 디렉토리 생성 실패: %w", err)
+	}
+	privPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(privPath, privPEM, 0600); err != nil {
+		return nil, i18n.Errorf("개인 키 저장 실패: %w", err)
+	}
+	if err := os.WriteFile(pubPath, []byte(FormatPublicKey(pub, name)+"\n"), 0644); err != nil {
+		return nil, i18n.Errorf("공개 키 저장 실패: %w", err)
+	}
+
+	return pub, nil
+}
+
+// LoadPrivateKey 저장된 개인 키 로드
+func LoadPrivateKey(name string) (ed25519.PrivateKey, error) {
+	privPath, _, err := keyPaths(name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(privPath)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, i18n.Errorf("개인 키 파일 형식이 올바르지 않습니다: %s", privPath)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, i18n.Errorf("개인 키 파싱 실패: %w", err)
+	}
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, i18n.Errorf("ed25519 개인 키가 아닙니다: %s", privPath)
+	}
+
+	return priv, nil
+}
+
+// Sign 데이터 서명
+func Sign(data []byte, priv ed25519.PrivateKey) Signature {
+	pub := priv.Public().(ed25519.PublicKey)
+	return Signature{
+		Algorithm: Algorithm,
+		KeyID:     KeyID(pub),
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(priv, data)),
+	}
+}
+
+// Marshal 서명을 JSON 문자열로 변환
+func (s Signature) Marshal() (string, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", i18n.Errorf("서명 변환 실패: %w", err)
+	}
+	return string(data), nil
+}
+
+// ParseSignature JSON 문자열에서 서명 파싱
+func ParseSignature(data string) (Signature, error) {
+	var sig Signature
+	if err := json.Unmarshal([]byte(data), &sig); err != nil {
+		return sig, i18n.Errorf("서명 파싱 실패: %w", err)
+	}
+	return sig, nil
+}
+
+// LoadTrustedKeys 신뢰하는 공개 키 목록 로드 (파일이 없으면 빈 목록)
+func LoadTrustedKeys() ([]TrustedKey, error) {
+	path, err := trustedKeysPath()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, i18n.Errorf("신뢰 키 목록을 읽을 수 없습니다: %w", err)
+	}
+	defer file.Close()
+
+	var keys []TrustedKey
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		pub, comment, err := ParsePublicKey(line)
+		if err != nil {
+			return nil, i18n.Errorf("신뢰 키 목록 파싱 실패 (%s): %w", path, err)
+		}
+		keys = append(keys, TrustedKey{ID: KeyID(pub), Key: pub, Comment: comment})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, i18n.Errorf("신뢰 키 목록을 읽을 수 없습니다: %w", err)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys, nil
+}
+
+// AddTrustedKey 신뢰하는 공개 키 추가 (이미 있으면 무시)
+func AddTrustedKey(pub ed25519.PublicKey, comment string) error {
+	keys, err := LoadTrustedKeys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.ID == KeyID(pub) {
+			return nil
+		}
+	}
+
+	path, err := trustedKeysPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return i18n.Errorf("설정 디렉토리 생성 실패: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return i18n.Errorf("신뢰 키 목록을 열 수 없습니다: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := fmt.Fprintln(file, FormatPublicKey(pub, comment)); err != nil {
+		return i18n.Errorf("신뢰 키 저장 실패: %w", err)
+	}
+	return file.Close()
+}
+
+// Verify 신뢰하는 공개 키로 서명 검증
+// 서명이 없으면 ErrUnsigned, 신뢰하지 않는 키면 ErrUntrustedKey,
+// 내용과 맞지 않으면 ErrInvalidSignature를 반환한다.
+func Verify(data []byte, sigData string) (TrustedKey, error) {
+	if sigData == "" {
+		return TrustedKey{}, ErrUnsigned
+	}
+
+	sig, err := ParseSignature(sigData)
+	if err != nil {
+		return TrustedKey{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if sig.Algorithm != Algorithm {
+		return TrustedKey{}, i18n.Errorf("%w: 지원하지 않는 알고리즘 %s", ErrInvalidSignature, sig.Algorithm)
+	}
+
+	keys, err := LoadTrustedKeys()
+	if err != nil {
+		return TrustedKey{}, err
+	}
+
+	for _, key := range keys {
+		if key.ID != sig.KeyID {
+			continue
+		}
+		raw, err := base64.StdEncoding.DecodeString(sig.Signature)
+		if err != nil || !ed25519.Verify(key.Key, data, raw) {
+			return key, ErrInvalidSignature
+		}
+		return key, nil
+	}
+
+	return TrustedKey{}, i18n.Errorf("%w (키 ID: %s)", ErrUntrustedKey, sig.KeyID)
+}
+
+// trustedKeysPath 신뢰 키 목록 파일 경로
+func trustedKeysPath() (string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, trustedKeysFile), nil
+}
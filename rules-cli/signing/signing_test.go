@@ -0,0 +1,82 @@
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	trustedPub, trustedPriv, _ := ed25519.GenerateKey(rand.Reader)
+	_, otherPriv, _ := ed25519.GenerateKey(rand.Reader)
+	if err := AddTrustedKey(trustedPub, "team"); err != nil {
+		t.Fatalf("AddTrustedKey(): %v", err)
+	}
+
+	data := []byte(`{"name":"acme/rules"}`)
+	sign := func(priv ed25519.PrivateKey, data []byte) string {
+		sig, err := Sign(data, priv).Marshal()
+		if err != nil {
+			t.Fatalf("Marshal(): %v", err)
+		}
+		return sig
+	}
+	wrongAlgorithm := Sign(data, trustedPriv)
+	wrongAlgorithm.Algorithm = "rsa"
+	wrongAlgorithmSig, _ := wrongAlgorithm.Marshal()
+
+	tests := []struct {
+		name    string
+		data    []byte
+		sig     string
+		wantErr error
+	}{
+		{"신뢰하는 키", data, sign(trustedPriv, data), nil},
+		{"서명 없음", data, "", ErrUnsigned},
+		{"내용 변경", []byte(`{"name":"evil/rules"}`), sign(trustedPriv, data), ErrInvalidSignature},
+		{"신뢰하지 않는 키", data, sign(otherPriv, data), ErrUntrustedKey},
+		{"지원하지 않는 알고리즘", data, wrongAlgorithmSig, ErrInvalidSignature},
+		{"서명 형식 오류", data, "not json", ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := Verify(tt.data, tt.sig)
+			if tt.wantErr == nil {
+				if err != nil || key.ID != KeyID(trustedPub) {
+					t.Errorf("Verify() = %v, %v, want key %s", key.ID, err, KeyID(trustedPub))
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyPathsName(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"default", true},
+		{"team-ci_2", true},
+		{"../../x", false},
+		{"a/b", false},
+		{"Upper", false},
+		{"-lead", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		_, _, err := keyPaths(tt.name)
+		if (err == nil) != tt.valid {
+			t.Errorf("keyPaths(%q) error = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/tinysolver/rules-cli/clierr"
+	"github.com/tinysolver/rules-cli/diff"
+	"github.com/tinysolver/rules-cli/filesystem"
+	"github.com/tinysolver/rules-cli/gist"
+	"github.com/tinysolver/rules-cli/i18n"
+	"github.com/tinysolver/rules-cli/lock"
+	"github.com/tinysolver/rules-cli/models"
+	"github.com/tinysolver/rules-cli/project"
+)
+
+// 로컬 파일 상태
+const (
+	fileUnchanged = "unchanged"
+	fileModified  = "modified"
+	fileMissing   = "missing"
+)
+
+// fileStatus 템플릿 파일 하나의 로컬 상태
+type fileStatus struct {
+	Path   string `json:"path"`
+	Status string `json:"status"`         // unchanged, modified, missing
+	Diff   string `json:"diff,omitempty"` // 원격(a)과 로컬(b)의 unified diff (diff 명령만)
+}
+
+// templateStatus 설치된 템플릿 하나의 로컬 상태
+type templateStatus struct {
+	Name     string       `json:"name"`
+	Revision string       `json:"revision"`
+	Version  string       `json:"version,omitempty"`
+	Files    []fileStatus `json:"files"`
+}
+
+// ignoredFile .cursorrulesignore에 의해 비교에서 제외한 파일
+type ignoredFile struct {
+	Path   string `json:"path"`
+	Reason string `json:"reason"` // 일치한 패턴 (파일: 패턴)
+}
+
+// statusResult status 명령 결과
+type statusResult struct {
+	Templates []templateStatus `json:"templates"`
+	Untracked []string         `json:"untracked"`         // 잠금 파일의 어떤 템플릿에도 속하지 않는 로컬 규칙 파일
+	Ignored   []ignoredFile    `json:"ignored,omitempty"` // 제외한 파일 (--show-ignored)
+}
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "설치한 템플릿과 로컬 파일의 상태 출력",
+	Long: "cursorrules.lock에 기록된 해시와 로컬 파일을 비교해 수정되거나 삭제된 파일을 보여줍니다.\n" +
+		"어떤 템플릿에도 속하지 않는 로컬 규칙 파일은 미추적 파일로 표시합니다. 네트워크를 사용하지 않습니다.\n" +
+		".cursorrulesignore에 해당하는 파일은 비교하지 않습니다.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		showIgnored, _ := cmd.Flags().GetBool("show-ignored")
+
+		projectDir, err := filesystem.GetProjectDir()
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+		lockfile, err := lock.Load(projectDir)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+		ignore, err := filesystem.LoadIgnoreMatcher()
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		result := statusResult{Templates: []templateStatus{}, Untracked: []string{}}
+		ignored := make(map[string]string)
+		tracked := make(map[string]bool)
+		for _, name := range lockfile.Names() {
+			entry := lockfile.Templates[name]
+			status := templateStatus{Name: name, Revision: entry.Revision, Version: entry.Version, Files: []fileStatus{}}
+
+			for _, path := range sortedKeys(entry.Files) {
+				tracked[path] = true
+				if ok, reason := ignore.MatchRule(path); ok {
+					ignored[path] = reason
+					continue
+				}
+				state, err := localFileStatus(path, entry.Files[path])
+				if err != nil {
+					return fail(clierr.IO, err)
+				}
+				status.Files = append(status.Files, fileStatus{Path: path, Status: state})
+			}
+			result.Templates = append(result.Templates, status)
+		}
+
+		local, _, skipped, err := filesystem.LoadLocalTemplate()
+		if err != nil {
+			return fail(clierr.IO, i18n.Errorf("로컬 템플릿 로드 실패: %w", err))
+		}
+		for _, path := range templatePaths(local) {
+			if !tracked[path] {
+				result.Untracked = append(result.Untracked, path)
+			}
+		}
+		for _, file := range skipped {
+			if file.Ignored {
+				ignored[file.Path] = file.Reason
+			}
+		}
+		if showIgnored {
+			result.Ignored = ignoredFiles(ignored)
+		}
+
+		return printResult(result, func() {
+			if len(result.Templates) == 0 {
+				i18n.Printf("%s에 기록된 템플릿이 없습니다.\n", lock.FileName)
+			}
+			for _, status := range result.Templates {
+				fmt.Printf("%s %s\n", status.Name, describeLock(status.Revision, status.Version))
+				clean := true
+				for _, file := range status.Files {
+					if file.Status == fileUnchanged {
+						continue
+					}
+					clean = false
+					fmt.Printf("  %s: %s\n", fileStatusLabel(file.Status), file.Path)
+				}
+				if clean {
+					i18n.Printf("  변경 없음\n")
+				}
+			}
+			if len(result.Untracked) > 0 {
+				i18n.Printf("미추적 파일:\n")
+				for _, path := range result.Untracked {
+					fmt.Printf("  %s\n", path)
+				}
+			}
+			printIgnored(result.Ignored)
+		})
+	},
+}
+
+// diffResult diff 명령에서 템플릿 하나의 비교 결과
+type diffResult struct {
+	Name     string        `json:"name"`
+	Revision string        `json:"revision"`
+	Version  string        `json:"version,omitempty"`
+	Files    []fileStatus  `json:"files"`
+	Ignored  []ignoredFile `json:"ignored,omitempty"` // 제외한 파일 (--show-ignored)
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff [name][@revision|@version]",
+	Short: "로컬 파일과 원격 템플릿의 차이 출력",
+	Long: "로컬 규칙 파일과 원격 템플릿의 차이를 unified diff로 보여줍니다.\n" +
+		"이름을 지정하면 해당 템플릿의 최신 수정 이력(또는 @참조)과 비교하고,\n" +
+		"지정하지 않으면 cursorrules.lock의 모든 템플릿을 설치한 수정 이력과 비교합니다.\n" +
+		".cursorrulesignore에 해당하는 파일은 비교하지 않습니다.",
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		showIgnored, _ := cmd.Flags().GetBool("show-ignored")
+		ignore, err := filesystem.LoadIgnoreMatcher()
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+
+		client, err := gist.NewGistClient()
+		if err != nil {
+			return fail(clierr.Auth, i18n.Errorf("Gist 클라이언트 생성 실패: %w", err))
+		}
+
+		projectDir, err := filesystem.GetProjectDir()
+		if err != nil {
+			return fail(clierr.IO, err)
+		}
+		declared, err := project.Load(projectDir)
+		if err != nil {
+			return fail(clierr.Validation, err)
+		}
+
+		// 선언된 템플릿은 설치할 때처럼 변수와 ignore를 적용한 내용과 비교
+		var remotes []*remoteTemplate
+		if len(args) == 1 {
+			templateName, ref := parseTemplateRef(args[0])
+			remote, err := fetchTemplate(client, templateName, ref)
+			if err != nil {
+				return fail(clierr.Internal, err)
+			}
+			remote.Template, _ = renderDeclared(declared, templateName, remote.Template)
+			remotes = append(remotes, remote)
+		} else {
+			lockfile, err := lock.Load(projectDir)
+			if err != nil {
+				return fail(clierr.Validation, err)
+			}
+			for _, name := range lockfile.Names() {
+				remote, err := fetchLocked(client, declared, name, lockfile.Templates[name])
+				if err != nil {
+					return fail(clierr.Internal, i18n.Errorf("템플릿 '%s' 조회 실패: %w", name, err))
+				}
+				remote.Template, _ = renderDeclared(declared, name, remote.Template)
+				remotes = append(remotes, remote)
+			}
+		}
+
+		results := make([]diffResult, 0, len(remotes))
+		for _, remote := range remotes {
+			files, ignored, err := diffLocal(remote.Template, ignore)
+			if err != nil {
+				return fail(clierr.IO, err)
+			}
+			result := diffResult{
+				Name:     remote.Template.Name,
+				Revision: remote.Revision,
+				Version:  remote.Version,
+				Files:    files,
+			}
+			if showIgnored {
+				result.Ignored = ignoredFiles(ignored)
+			}
+			results = append(results, result)
+		}
+
+		return printResult(results, func() {
+			for _, result := range results {
+				printIgnored(result.Ignored)
+				for _, file := range result.Files {
+					if file.Status == fileUnchanged {
+						continue
+					}
+					fmt.Printf("%s %s: %s (%s)\n", result.Name, describeLock(result.Revision, result.Version), file.Path, fileStatusLabel(file.Status))
+					fmt.Print(colorizeDiff(file.Diff))
+				}
+			}
+		})
+	},
+}
+
+// diffLocal 템플릿의 각 파일을 로컬 파일과 비교
+// 무시 패턴에 해당하는 파일은 비교하지 않고, 경로와 일치한 패턴을 따로 반환한다.
+func diffLocal(template *models.Template, ignore *filesystem.IgnoreMatcher) ([]fileStatus, map[string]string, error) {
+	files := make([]fileStatus, 0, len(template.Files))
+	ignored := make(map[string]string)
+	for _, path := range templatePaths(template) {
+		if ok, reason := ignore.MatchRule(path); ok {
+			ignored[path] = reason
+			continue
+		}
+		remoteContent := template.Files[path].Content
+
+		file := fileStatus{Path: path, Status: fileUnchanged}
+		localContent, err := filesystem.ReadRuleFile(path)
+		switch {
+		case os.IsNotExist(err):
+			file.Status = fileMissing
+			file.Diff = diff.Unified(remoteContent, "", "a/"+path, "/dev/null")
+		case err != nil:
+			return nil, nil, err
+		case localContent != remoteContent:
+			file.Status = fileModified
+			file.Diff = diff.Unified(remoteContent, localContent, "a/"+path, "b/"+path)
+		}
+		files = append(files, file)
+	}
+	return files, ignored, nil
+}
+
+// ignoredFiles 제외한 파일 목록 (경로순)
+func ignoredFiles(ignored map[string]string) []ignoredFile {
+	files := make([]ignoredFile, 0, len(ignored))
+	for _, path := range sortedKeys(ignored) {
+		files = append(files, ignoredFile{Path: path, Reason: ignored[path]})
+	}
+	return files
+}
+
+// printIgnored 제외한 파일 출력
+func printIgnored(files []ignoredFile) {
+	for _, file := range files {
+		i18n.Printf("무시됨: '%s' (%s)\n", file.Path, file.Reason)
+	}
+}
+
+// localFileStatus 로컬 파일 내용을 잠금 파일의 해시와 비교
+func localFileStatus(path, hash string) (string, error) {
+	content, err := filesystem.ReadRuleFile(path)
+	if os.IsNotExist(err) {
+		return fileMissing, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if models.HashContent(content) != hash {
+		return fileModified, nil
+	}
+	return fileUnchanged, nil
+}
+
+// fileStatusLabel 로컬 파일 상태 표시
+func fileStatusLabel(status string) string {
+	switch status {
+	case fileModified:
+		return i18n.T("수정됨")
+	case fileMissing:
+		return i18n.T("없음")
+	default:
+		return i18n.T("변경 없음")
+	}
+}
+
+// sortedKeys 맵의 키 (정렬)
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
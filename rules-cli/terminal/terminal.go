@@ -0,0 +1,53 @@
+package terminal
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// IsTerminal 파일이 터미널인지 확인
+func IsTerminal(file *os.File) bool {
+	return term.IsTerminal(int(file.Fd()))
+}
+
+// ReadPassword 입력 내용을 화면에 표시하지 않고 한 줄 읽기
+// 터미널이 아니면 일반 입력처럼 한 줄을 읽는다.
+func ReadPassword(file *os.File) (string, error) {
+	if !IsTerminal(file) {
+		return ReadLine(file)
+	}
+
+	password, err := term.ReadPassword(int(file.Fd()))
+	// 입력한 줄바꿈이 표시되지 않으므로 직접 출력
+	os.Stderr.WriteString("\n")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(password), "\r"), nil
+}
+
+// ReadLine 파일에서 한 줄 읽기 (줄바꿈 제거)
+// 이후 입력을 다른 Reader가 읽을 수 있도록 줄바꿈까지만 한 바이트씩 읽는다.
+func ReadLine(file io.Reader) (string, error) {
+	var line []byte
+	buf := make([]byte, 1)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if buf[0] == '\n' {
+				break
+			}
+			line = append(line, buf[0])
+		}
+		if err != nil {
+			if err == io.EOF && len(line) > 0 {
+				break
+			}
+			return "", err
+		}
+	}
+	return strings.TrimRight(string(line), "\r"), nil
+}
@@ -0,0 +1,64 @@
+package terminal
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"줄바꿈까지", "token\nrest", "token", false},
+		{"CRLF", "token\r\nrest", "token", false},
+		{"줄바꿈 없이 끝남", "token", "token", false},
+		{"빈 줄", "\nrest", "", false},
+		{"빈 입력", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadLine(strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ReadLine(%q) = %q, %v, want %q (error %v)", tt.input, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReadLineLeavesRest(t *testing.T) {
+	reader := strings.NewReader("first\nsecond\n")
+	if _, err := ReadLine(reader); err != nil {
+		t.Fatal(err)
+	}
+	rest, _ := io.ReadAll(reader)
+	if string(rest) != "second\n" {
+		t.Errorf("남은 입력 = %q, want %q", rest, "second\n")
+	}
+}
+
+func TestReadPasswordNotTerminal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input")
+	if err := os.WriteFile(path, []byte("secret\r\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	if IsTerminal(file) {
+		t.Fatal("IsTerminal(file) = true, want false")
+	}
+	got, err := ReadPassword(file)
+	if err != nil || got != "secret" {
+		t.Errorf("ReadPassword() = %q, %v, want %q", got, err, "secret")
+	}
+}